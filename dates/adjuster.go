@@ -0,0 +1,251 @@
+package dates
+
+import (
+	"fmt"
+	"time"
+)
+
+// AdjusterKind kind of adjuster
+type AdjusterKind int
+
+// adjuster kind enums
+const (
+	AdjustNone AdjusterKind = iota
+	AdjustFirstDayOfMonth
+	AdjustLastDayOfMonth
+	AdjustFirstDayOfNextMonth
+	AdjustFirstDayOfYear
+	AdjustLastDayOfYear
+	AdjustFirstDayOfNextYear
+	AdjustNext
+	AdjustNextOrSame
+	AdjustPrevious
+	AdjustPreviousOrSame
+	AdjustDayOfWeekInMonth
+	AdjustPlusDays
+)
+
+var _AdjusterKindNameMap = map[AdjusterKind]string{
+	AdjustNone:                "None",
+	AdjustFirstDayOfMonth:     "FirstDayOfMonth",
+	AdjustLastDayOfMonth:      "LastDayOfMonth",
+	AdjustFirstDayOfNextMonth: "FirstDayOfNextMonth",
+	AdjustFirstDayOfYear:      "FirstDayOfYear",
+	AdjustLastDayOfYear:       "LastDayOfYear",
+	AdjustFirstDayOfNextYear:  "FirstDayOfNextYear",
+	AdjustNext:                "Next",
+	AdjustNextOrSame:          "NextOrSame",
+	AdjustPrevious:            "Previous",
+	AdjustPreviousOrSame:      "PreviousOrSame",
+	AdjustDayOfWeekInMonth:    "DayOfWeekInMonth",
+	AdjustPlusDays:            "PlusDays",
+}
+
+// String to string
+func (k AdjusterKind) String() string {
+	return _AdjusterKindNameMap[k]
+}
+
+// MarshalText adjuster kind is marshaled by name
+func (k AdjusterKind) MarshalText() ([]byte, error) {
+	name, ok := _AdjusterKindNameMap[k]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown adjuster kind: %d", ErrMarshalJSON, k)
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText adjuster kind is unmarshaled by name
+func (k *AdjusterKind) UnmarshalText(text []byte) error {
+	for kind, name := range _AdjusterKindNameMap {
+		if name == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown adjuster kind: %s", ErrUnmarshalJSON, text)
+}
+
+// Adjuster adjusts a localDate by a calendar rule, such as "the last friday of the month".
+// Since it is a plain value, rules can be stored as data (json etc.) and applied uniformly.
+//
+//	Weekday: used by Next, NextOrSame, Previous, PreviousOrSame and DayOfWeekInMonth
+//	N:       used by DayOfWeekInMonth(ordinal) and PlusDays(days)
+type Adjuster struct {
+	Kind    AdjusterKind `json:"kind"`
+	Weekday time.Weekday `json:"weekday,omitempty"`
+	N       int          `json:"n,omitempty"`
+}
+
+// Adjusters composed adjusters. they are applied in order.
+type Adjusters []Adjuster
+
+// FirstDayOfMonth adjuster to the first day of the month
+func FirstDayOfMonth() Adjuster {
+	return Adjuster{Kind: AdjustFirstDayOfMonth}
+}
+
+// LastDayOfMonth adjuster to the last day of the month
+func LastDayOfMonth() Adjuster {
+	return Adjuster{Kind: AdjustLastDayOfMonth}
+}
+
+// FirstDayOfNextMonth adjuster to the first day of the next month
+// If the result exceeds MaxYear, it will return empty. (same as PlusDays)
+func FirstDayOfNextMonth() Adjuster {
+	return Adjuster{Kind: AdjustFirstDayOfNextMonth}
+}
+
+// FirstDayOfYear adjuster to the first day of the year
+func FirstDayOfYear() Adjuster {
+	return Adjuster{Kind: AdjustFirstDayOfYear}
+}
+
+// LastDayOfYear adjuster to the last day of the year
+func LastDayOfYear() Adjuster {
+	return Adjuster{Kind: AdjustLastDayOfYear}
+}
+
+// FirstDayOfNextYear adjuster to the first day of the next year
+// If the result exceeds MaxYear, it will return empty. (same as PlusDays)
+func FirstDayOfNextYear() Adjuster {
+	return Adjuster{Kind: AdjustFirstDayOfNextYear}
+}
+
+// Next adjuster to the next weekday. (the same day is not included)
+func Next(weekday time.Weekday) Adjuster {
+	return Adjuster{Kind: AdjustNext, Weekday: weekday}
+}
+
+// NextOrSame adjuster to the next weekday. (the same day is included)
+func NextOrSame(weekday time.Weekday) Adjuster {
+	return Adjuster{Kind: AdjustNextOrSame, Weekday: weekday}
+}
+
+// Previous adjuster to the previous weekday. (the same day is not included)
+func Previous(weekday time.Weekday) Adjuster {
+	return Adjuster{Kind: AdjustPrevious, Weekday: weekday}
+}
+
+// PreviousOrSame adjuster to the previous weekday. (the same day is included)
+func PreviousOrSame(weekday time.Weekday) Adjuster {
+	return Adjuster{Kind: AdjustPreviousOrSame, Weekday: weekday}
+}
+
+// DayOfWeekInMonth adjuster to the n-th weekday in the month.
+//
+//	n > 0: n-th weekday from the start of the month. (5th may be in the next month)
+//	n < 0: n-th weekday from the end of the month. (-1 is the last)
+//	n = 0: the last weekday of the previous month.
+func DayOfWeekInMonth(n int, weekday time.Weekday) Adjuster {
+	return Adjuster{Kind: AdjustDayOfWeekInMonth, Weekday: weekday, N: n}
+}
+
+// FirstInMonth adjuster to the first weekday in the month
+func FirstInMonth(weekday time.Weekday) Adjuster {
+	return DayOfWeekInMonth(1, weekday)
+}
+
+// LastInMonth adjuster to the last weekday in the month
+func LastInMonth(weekday time.Weekday) Adjuster {
+	return DayOfWeekInMonth(-1, weekday)
+}
+
+// PlusDays adjuster to add days
+func PlusDays(days int) Adjuster {
+	return Adjuster{Kind: AdjustPlusDays, N: days}
+}
+
+// Compose composes adjusters. they are applied in order.
+//
+//	Compose(LastInMonth(time.Friday), PreviousOrSame(time.Thursday))
+func Compose(adjusters ...Adjuster) Adjusters {
+	return adjusters
+}
+
+// Adjust adjusts the localDate
+func (a Adjuster) Adjust(d LocalDate) LocalDate {
+	switch a.Kind {
+	case AdjustFirstDayOfMonth:
+		return LocalDate{Year: d.Year, Month: d.Month, Day: MinDayOfMonth}
+	case AdjustLastDayOfMonth:
+		return YearMonthFromDate(d).AtEndOfMonth()
+	case AdjustFirstDayOfNextMonth:
+		if d.Year == MaxYear && d.Month == MaxMonthOfYear {
+			return LocalDate{}
+		}
+		return YearMonthFromDate(d).PlusMonths(1).Period().Start
+	case AdjustFirstDayOfYear:
+		return LocalDate{Year: d.Year, Month: MinMonthOfYear, Day: MinDayOfMonth}
+	case AdjustLastDayOfYear:
+		return LocalDate{Year: d.Year, Month: MaxMonthOfYear, Day: MaxDayOfMonth}
+	case AdjustFirstDayOfNextYear:
+		if MaxYear <= d.Year {
+			return LocalDate{}
+		}
+		return LocalDate{Year: d.Year + 1, Month: MinMonthOfYear, Day: MinDayOfMonth}
+	case AdjustNext:
+		return addDays(d, daysUntil(d.Weekday(), a.Weekday, false))
+	case AdjustNextOrSame:
+		return addDays(d, daysUntil(d.Weekday(), a.Weekday, true))
+	case AdjustPrevious:
+		return addDays(d, -daysUntil(a.Weekday, d.Weekday(), false))
+	case AdjustPreviousOrSame:
+		return addDays(d, -daysUntil(a.Weekday, d.Weekday(), true))
+	case AdjustDayOfWeekInMonth:
+		return dayOfWeekInMonth(d, a.N, a.Weekday)
+	case AdjustPlusDays:
+		return addDays(d, a.N)
+	default:
+		return d
+	}
+}
+
+// AdjustDatetime adjusts the localDate of the localDatetime. the localTime is kept.
+func (a Adjuster) AdjustDatetime(dt LocalDatetime) LocalDatetime {
+	return LocalDatetime{LocalDate: a.Adjust(dt.LocalDate), LocalTime: dt.LocalTime}
+}
+
+// Adjust adjusts the localDate by adjusters in order
+func (as Adjusters) Adjust(d LocalDate) LocalDate {
+	for _, a := range as {
+		d = a.Adjust(d)
+	}
+	return d
+}
+
+// AdjustDatetime adjusts the localDate of the localDatetime by adjusters in order. the localTime is kept.
+func (as Adjusters) AdjustDatetime(dt LocalDatetime) LocalDatetime {
+	return LocalDatetime{LocalDate: as.Adjust(dt.LocalDate), LocalTime: dt.LocalTime}
+}
+
+// With returns the localDate adjusted by adjusters in order
+func (d LocalDate) With(adjusters ...Adjuster) LocalDate {
+	return Adjusters(adjusters).Adjust(d)
+}
+
+// With returns the localDatetime adjusted by adjusters in order. the localTime is kept.
+func (dt LocalDatetime) With(adjusters ...Adjuster) LocalDatetime {
+	return Adjusters(adjusters).AdjustDatetime(dt)
+}
+
+// daysUntil days from "from" weekday to "to" weekday. 0 is returned only when includeSame is true.
+func daysUntil(from, to time.Weekday, includeSame bool) int {
+	days := int(floorMod(int64(to-from), DaysOfWeek))
+	if days == 0 && !includeSame {
+		return DaysOfWeek
+	}
+	return days
+}
+
+func dayOfWeekInMonth(d LocalDate, n int, weekday time.Weekday) LocalDate {
+	if n > 0 {
+		first := FirstDayOfMonth().Adjust(d)
+		return addDays(NextOrSame(weekday).Adjust(first), (n-1)*DaysOfWeek)
+	}
+	if n == 0 {
+		return Previous(weekday).Adjust(FirstDayOfMonth().Adjust(d))
+	}
+	last := LastDayOfMonth().Adjust(d)
+	return addDays(PreviousOrSame(weekday).Adjust(last), (n+1)*DaysOfWeek)
+}
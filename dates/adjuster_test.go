@@ -0,0 +1,78 @@
+package dates
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestAdjuster_Adjust(t *testing.T) {
+	// 2024-10-16 is Wednesday
+	base := NewLocalDate(2024, 10, 16)
+	for _, table := range []struct {
+		title    string
+		adjuster Adjuster
+		expect   LocalDate
+	}{
+		{title: "FirstDayOfMonth", adjuster: FirstDayOfMonth(), expect: NewLocalDate(2024, 10, 1)},
+		{title: "LastDayOfMonth", adjuster: LastDayOfMonth(), expect: NewLocalDate(2024, 10, 31)},
+		{title: "FirstDayOfNextMonth", adjuster: FirstDayOfNextMonth(), expect: NewLocalDate(2024, 11, 1)},
+		{title: "FirstDayOfYear", adjuster: FirstDayOfYear(), expect: NewLocalDate(2024, 1, 1)},
+		{title: "LastDayOfYear", adjuster: LastDayOfYear(), expect: NewLocalDate(2024, 12, 31)},
+		{title: "FirstDayOfNextYear", adjuster: FirstDayOfNextYear(), expect: NewLocalDate(2025, 1, 1)},
+		{title: "Next.同じ曜日は含まない", adjuster: Next(time.Wednesday), expect: NewLocalDate(2024, 10, 23)},
+		{title: "Next", adjuster: Next(time.Monday), expect: NewLocalDate(2024, 10, 21)},
+		{title: "NextOrSame.同じ曜日を含む", adjuster: NextOrSame(time.Wednesday), expect: base},
+		{title: "Previous.同じ曜日は含まない", adjuster: Previous(time.Wednesday), expect: NewLocalDate(2024, 10, 9)},
+		{title: "PreviousOrSame", adjuster: PreviousOrSame(time.Friday), expect: NewLocalDate(2024, 10, 11)},
+		{title: "PreviousOrSame.同じ曜日を含む", adjuster: PreviousOrSame(time.Wednesday), expect: base},
+		{title: "第2月曜日", adjuster: DayOfWeekInMonth(2, time.Monday), expect: NewLocalDate(2024, 10, 14)},
+		{title: "第5金曜日は翌月になる", adjuster: DayOfWeekInMonth(5, time.Friday), expect: NewLocalDate(2024, 11, 1)},
+		{title: "最後から2番目の木曜日", adjuster: DayOfWeekInMonth(-2, time.Thursday), expect: NewLocalDate(2024, 10, 24)},
+		{title: "0は前月の最後の曜日", adjuster: DayOfWeekInMonth(0, time.Tuesday), expect: NewLocalDate(2024, 9, 24)},
+		{title: "FirstInMonth", adjuster: FirstInMonth(time.Tuesday), expect: NewLocalDate(2024, 10, 1)},
+		{title: "LastInMonth", adjuster: LastInMonth(time.Friday), expect: NewLocalDate(2024, 10, 25)},
+		{title: "PlusDays", adjuster: PlusDays(-16), expect: NewLocalDate(2024, 9, 30)},
+		{title: "zero valueは何もしない", adjuster: Adjuster{}, expect: base},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			Equal(t, table.expect, table.adjuster.Adjust(base))
+		})
+	}
+}
+
+func TestAdjuster_Adjust_MaxYear(t *testing.T) {
+	last := LocalDate{Year: MaxYear, Month: 12, Day: 31}
+	Equal(t, LocalDate{}, FirstDayOfNextYear().Adjust(last), "MaxYearを超える場合はempty")
+	Equal(t, LocalDate{}, FirstDayOfNextMonth().Adjust(last), "MaxYearを超える場合はempty")
+	Equal(t, LocalDate{}, PlusDays(1).Adjust(last), "MaxYearを超える場合はempty")
+	Equal(t, LocalDate{Year: MaxYear, Month: 1, Day: 1}, FirstDayOfNextYear().Adjust(LocalDate{Year: MaxYear - 1, Month: 6, Day: 1}))
+}
+
+func TestAdjusters_Compose(t *testing.T) {
+	lastFriday := Compose(FirstDayOfNextMonth(), Previous(time.Friday))
+	Equal(t, NewLocalDate(2024, 2, 23), lastFriday.Adjust(NewLocalDate(2024, 2, 10)))
+	Equal(t, NewLocalDate(2024, 5, 31), lastFriday.Adjust(NewLocalDate(2024, 5, 1)))
+
+	Equal(t, NewLocalDate(2025, 1, 13), NewLocalDate(2024, 10, 16).With(FirstDayOfNextYear(), DayOfWeekInMonth(2, time.Monday)), "成人の日")
+
+	dtm := NewLocalDatetime(2024, 10, 16, 13, 14, 15)
+	Equal(t, NewLocalDatetime(2024, 10, 31, 13, 14, 15), dtm.With(LastDayOfMonth()), "時刻は維持される")
+	Equal(t, NewLocalDatetime(2024, 10, 1, 13, 14, 15), FirstDayOfMonth().AdjustDatetime(dtm))
+}
+
+func TestAdjusters_JSON(t *testing.T) {
+	rule := Compose(LastDayOfMonth(), PreviousOrSame(time.Friday))
+	jsonBytes, err := json.Marshal(rule)
+	Nil(t, err)
+	Equal(t, `[{"kind":"LastDayOfMonth"},{"kind":"PreviousOrSame","weekday":5}]`, string(jsonBytes))
+
+	var actual Adjusters
+	Nil(t, json.Unmarshal(jsonBytes, &actual))
+	Equal(t, rule, actual)
+	Equal(t, NewLocalDate(2024, 8, 30), actual.Adjust(NewLocalDate(2024, 8, 1)))
+
+	NotNil(t, json.Unmarshal([]byte(`[{"kind":"Unknown"}]`), &actual))
+}
@@ -0,0 +1,145 @@
+package dates
+
+import "time"
+
+// calendar const
+const (
+	DaysOfYear     = 365
+	DaysOfLeapYear = 366
+	// daysFrom0000To1970 days from 0000-03-01 to 1970-01-01
+	daysFrom0000To1970 int64 = 719468
+	daysOf400Years     int64 = 146097
+)
+
+// cumulative days before each month in a non-leap year
+var _DaysBeforeMonth = [...]int{0, 0, 31, 59, 90, 120, 151, 181, 212, 243, 273, 304, 334}
+
+// Weekday returns the day of the week of the localDate.
+func (d LocalDate) Weekday() time.Weekday {
+	// 1970-01-01 is Thursday
+	return time.Weekday(floorMod(d.EpochDay()+int64(time.Thursday), DaysOfWeek))
+}
+
+// DayOfYear returns the day of the year(1~365 or 366).
+func (d LocalDate) DayOfYear() int {
+	if d.Month < MinMonthOfYear || MaxMonthOfYear < d.Month {
+		return int(d.Day)
+	}
+	days := _DaysBeforeMonth[d.Month] + int(d.Day)
+	if d.Month > 2 && d.IsLeapYear() {
+		days++
+	}
+	return days
+}
+
+// LengthOfMonth returns the number of days in the month of the localDate.
+func (d LocalDate) LengthOfMonth() int {
+	return int(daysInMonth(d.Year, d.Month))
+}
+
+// LengthOfYear returns the number of days in the year of the localDate.(365 or 366)
+func (d LocalDate) LengthOfYear() int {
+	if d.IsLeapYear() {
+		return DaysOfLeapYear
+	}
+	return DaysOfYear
+}
+
+// IsLeapYear reports whether the year of the localDate is a leap year.
+func (d LocalDate) IsLeapYear() bool {
+	return isLeapYear(d.Year)
+}
+
+// Quarter returns the calendar quarter(1~4) of the localDate. zero localDate is 0.
+func (d LocalDate) Quarter() int {
+	if d.Month < MinMonthOfYear {
+		return 0
+	}
+	return int((d.Month-MinMonthOfYear)/MonthsOfQuarter) + 1
+}
+
+// WeekOfMonth returns the week of the month based on weekStart.
+// The week including the first day of the month is week 1.
+//
+//	2024-10-16(Wed), weekStart: Sunday ---> 3
+func (d LocalDate) WeekOfMonth(weekStart time.Weekday) int {
+	first := LocalDate{Year: d.Year, Month: d.Month, Day: MinDayOfMonth}
+	offset := floorMod(int64(first.Weekday()-weekStart), DaysOfWeek)
+	return int((offset+int64(d.Day)-1)/DaysOfWeek) + 1
+}
+
+// EpochDay returns the number of days since 1970-01-01.
+// It is computed arithmetically, so it works in the range of MinYear to MaxYear.
+func (d LocalDate) EpochDay() int64 {
+	y, m := int64(d.Year), int64(d.Month)
+	if m <= 2 {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yearOfEra := y - era*400
+	dayOfYear := (153*((m+9)%12)+2)/5 + int64(d.Day) - 1
+	dayOfEra := yearOfEra*DaysOfYear + yearOfEra/4 - yearOfEra/100 + dayOfYear
+	return era*daysOf400Years + dayOfEra - daysFrom0000To1970
+}
+
+// LocalDateOfEpochDay returns the localDate of the number of days since 1970-01-01.
+// If the result is in BC or exceeds MaxYear, it will return empty.
+func LocalDateOfEpochDay(epochDay int64) LocalDate {
+	z := epochDay + daysFrom0000To1970
+	era := floorDiv(z, daysOf400Years)
+	dayOfEra := z - era*daysOf400Years
+	yearOfEra := (dayOfEra - dayOfEra/1460 + dayOfEra/36524 - dayOfEra/146096) / DaysOfYear
+	y := yearOfEra + era*400
+	dayOfYear := dayOfEra - (DaysOfYear*yearOfEra + yearOfEra/4 - yearOfEra/100)
+	mp := (5*dayOfYear + 2) / 153
+	day := dayOfYear - (153*mp+2)/5 + 1
+	month := mp + 3
+	if mp >= 10 {
+		month = mp - 9
+	}
+	if month <= 2 {
+		y++
+	}
+	if y < 1 || int64(MaxYear) < y {
+		return LocalDate{}
+	}
+	return LocalDate{Year: uint(y), Month: uint(month), Day: uint(day)}
+}
+
+func isLeapYear(year uint) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysInMonth(year, month uint) uint {
+	switch month {
+	case 2:
+		if isLeapYear(year) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	default:
+		return MaxDayOfMonth
+	}
+}
+
+func addDays(d LocalDate, days int) LocalDate {
+	return LocalDateOfEpochDay(d.EpochDay() + int64(days))
+}
+
+func daysBetween(start, end LocalDate) int {
+	return int(end.EpochDay() - start.EpochDay())
+}
+
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(x, y int64) int64 {
+	return x - floorDiv(x, y)*y
+}
@@ -0,0 +1,76 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDate_EpochDay(t *testing.T) {
+	Equal(t, int64(0), NewLocalDate(1970, 1, 1).EpochDay())
+	Equal(t, int64(-1), NewLocalDate(1969, 12, 31).EpochDay())
+	Equal(t, int64(19646), NewLocalDate(2023, 10, 16).EpochDay())
+	Equal(t, NewLocalDate(1, 1, 1), LocalDateOfEpochDay(NewLocalDate(1, 1, 1).EpochDay()), "西暦元年")
+	Equal(t, LocalDate{}, LocalDateOfEpochDay(NewLocalDate(1, 1, 1).EpochDay()-1), "紀元前の場合空を返却する")
+
+	maxDate := LocalDate{Year: MaxYear, Month: 12, Day: 31}
+	Equal(t, maxDate, LocalDateOfEpochDay(maxDate.EpochDay()), "MaxYearまで計算できる")
+	Equal(t, LocalDate{}, LocalDateOfEpochDay(maxDate.EpochDay()+1), "MaxYearを超える場合空を返却する")
+}
+
+func TestLocalDate_CalendarFieldsMatchTime(t *testing.T) {
+	start := NewLocalDate(1899, 12, 25)
+	for i := 0; i < 365*250; i += 13 {
+		tm := start.ToTimeUtc().AddDate(0, 0, i)
+		d := LocalDateFromTime(tm)
+		epochDay := tm.Unix() / (24 * 60 * 60)
+		Equal(t, epochDay, d.EpochDay(), d.String())
+		Equal(t, d, LocalDateOfEpochDay(epochDay), d.String())
+		Equal(t, tm.Weekday(), d.Weekday(), d.String())
+		Equal(t, tm.YearDay(), d.DayOfYear(), d.String())
+	}
+}
+
+func TestLocalDate_CalendarFields(t *testing.T) {
+	for _, table := range []struct {
+		title               string
+		date                LocalDate
+		expectLengthOfMonth int
+		expectLengthOfYear  int
+		expectLeapYear      bool
+		expectQuarter       int
+		expectWeekday       time.Weekday
+	}{
+		{title: "閏年の2月", date: NewLocalDate(2024, 2, 29), expectLengthOfMonth: 29, expectLengthOfYear: 366, expectLeapYear: true, expectQuarter: 1, expectWeekday: time.Thursday},
+		{title: "平年の2月", date: NewLocalDate(2023, 2, 1), expectLengthOfMonth: 28, expectLengthOfYear: 365, expectLeapYear: false, expectQuarter: 1, expectWeekday: time.Wednesday},
+		{title: "1900年は平年", date: NewLocalDate(1900, 6, 30), expectLengthOfMonth: 30, expectLengthOfYear: 365, expectLeapYear: false, expectQuarter: 2, expectWeekday: time.Saturday},
+		{title: "10月", date: NewLocalDate(2024, 10, 16), expectLengthOfMonth: 31, expectLengthOfYear: 366, expectLeapYear: true, expectQuarter: 4, expectWeekday: time.Wednesday},
+		{title: "MaxYear", date: LocalDate{Year: MaxYear, Month: 9, Day: 1}, expectLengthOfMonth: 30, expectLengthOfYear: 365, expectLeapYear: false, expectQuarter: 3, expectWeekday: time.Wednesday},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			Equal(t, table.expectLengthOfMonth, table.date.LengthOfMonth())
+			Equal(t, table.expectLengthOfYear, table.date.LengthOfYear())
+			Equal(t, table.expectLeapYear, table.date.IsLeapYear())
+			Equal(t, table.expectQuarter, table.date.Quarter())
+			Equal(t, table.expectWeekday, table.date.Weekday())
+		})
+	}
+}
+
+func TestLocalDate_Quarter_Zero(t *testing.T) {
+	Equal(t, 0, LocalDate{}.Quarter(), "zero valueは0")
+	Equal(t, 0, LocalDate{Year: 2024}.Quarter(), "月が0の場合は0")
+}
+
+func TestLocalDate_WeekOfMonth(t *testing.T) {
+	// 2024-10-01 is Tuesday
+	Equal(t, 1, NewLocalDate(2024, 10, 1).WeekOfMonth(time.Sunday))
+	Equal(t, 1, NewLocalDate(2024, 10, 5).WeekOfMonth(time.Sunday))
+	Equal(t, 2, NewLocalDate(2024, 10, 6).WeekOfMonth(time.Sunday))
+	Equal(t, 3, NewLocalDate(2024, 10, 16).WeekOfMonth(time.Sunday))
+	Equal(t, 5, NewLocalDate(2024, 10, 31).WeekOfMonth(time.Sunday))
+	Equal(t, 1, NewLocalDate(2024, 10, 6).WeekOfMonth(time.Monday))
+	Equal(t, 2, NewLocalDate(2024, 10, 7).WeekOfMonth(time.Monday))
+	Equal(t, 2, NewLocalDate(2024, 10, 2).WeekOfMonth(time.Wednesday))
+}
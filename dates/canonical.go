@@ -0,0 +1,220 @@
+package dates
+
+import "errors"
+
+// text string or []byte. both are parsed without conversion.
+type text interface {
+	~string | ~[]byte
+}
+
+// canonicalField a numeric field of the canonical forms
+type canonicalField struct {
+	name string
+	// sep separator before the field. 0 when none
+	sep       byte
+	minDigits int
+	maxDigits int
+}
+
+// canonicalValue a parsed field and its byte offset
+type canonicalValue struct {
+	value  uint
+	offset int
+}
+
+// strict fields are the same as DateHyphen, DateTimeHyphen and MonthHyphen.
+// lenient fields accept 1 digit months, days and times. (yyyy-M-d H:m:s, yyyy-M, --M-d)
+var (
+	strictDateFields = []canonicalField{
+		{name: "year", minDigits: 4, maxDigits: 4},
+		{name: "month", sep: '-', minDigits: 2, maxDigits: 2},
+		{name: "day", sep: '-', minDigits: 2, maxDigits: 2},
+	}
+	strictDatetimeFields = append(strictDateFields[:len(strictDateFields):len(strictDateFields)],
+		canonicalField{name: "hour", sep: ' ', minDigits: 2, maxDigits: 2},
+		canonicalField{name: "minute", sep: ':', minDigits: 2, maxDigits: 2},
+		canonicalField{name: "second", sep: ':', minDigits: 2, maxDigits: 2},
+	)
+	strictYearMonthFields = strictDateFields[:2]
+	lenientDateFields     = []canonicalField{
+		{name: "year", minDigits: 4, maxDigits: 4},
+		{name: "month", sep: '-', minDigits: 1, maxDigits: 2},
+		{name: "day", sep: '-', minDigits: 1, maxDigits: 2},
+	}
+	lenientDatetimeFields = append(lenientDateFields[:len(lenientDateFields):len(lenientDateFields)],
+		canonicalField{name: "hour", sep: ' ', minDigits: 1, maxDigits: 2},
+		canonicalField{name: "minute", sep: ':', minDigits: 1, maxDigits: 2},
+		canonicalField{name: "second", sep: ':', minDigits: 1, maxDigits: 2},
+	)
+	lenientYearMonthFields = lenientDateFields[:2]
+	compactYearMonthFields = []canonicalField{
+		{name: "year", minDigits: 4, maxDigits: 4},
+		{name: "month", minDigits: 2, maxDigits: 2},
+	}
+	lenientMonthDayFields = []canonicalField{
+		{name: "month", minDigits: 1, maxDigits: 2},
+		{name: "day", sep: '-', minDigits: 1, maxDigits: 2},
+	}
+)
+
+// parseFields parses fields from s[i:] into values, and returns the index after the last field.
+// trailing text is not checked.
+func parseFields[T text](s T, i int, f Format, fields []canonicalField, values []canonicalValue) (int, error) {
+	for n, field := range fields {
+		if field.sep != 0 {
+			if len(s) <= i || s[i] != field.sep {
+				return i, &ParseError{Input: string(s), Format: f, Offset: i, Err: errUnmatched}
+			}
+			i++
+		}
+		start := i
+		var v uint
+		for i < len(s) && i-start < field.maxDigits && '0' <= s[i] && s[i] <= '9' {
+			v = v*10 + uint(s[i]-'0')
+			i++
+		}
+		if i-start < field.minDigits {
+			return i, &ParseError{Input: string(s), Format: f, Field: field.name, Offset: start, Err: errUnmatched}
+		}
+		values[n] = canonicalValue{value: v, offset: start}
+	}
+	return i, nil
+}
+
+// parseCanonicalDate parses yyyy-MM-dd as DateHyphen.
+func parseCanonicalDate[T text](s T) (LocalDate, error) {
+	var v [3]canonicalValue
+	if err := parseStrictFields(s, DateHyphen, strictDateFields, v[:]); err != nil {
+		return LocalDate{}, err
+	}
+	d, err := LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value}.Valid()
+	if err != nil {
+		return LocalDate{}, canonicalRangeError(s, DateHyphen, strictDateFields, v[:], err)
+	}
+	return d, nil
+}
+
+// parseCanonicalDatetime parses yyyy-MM-dd HH:mm:ss as DateTimeHyphen.
+func parseCanonicalDatetime[T text](s T) (LocalDatetime, error) {
+	var v [6]canonicalValue
+	if err := parseStrictFields(s, DateTimeHyphen, strictDatetimeFields, v[:]); err != nil {
+		return LocalDatetime{}, err
+	}
+	dt, err := LocalDatetime{
+		LocalDate: LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value},
+		LocalTime: LocalTime{Hour: v[3].value, Minute: v[4].value, Second: v[5].value},
+	}.Valid()
+	if err != nil {
+		return LocalDatetime{}, canonicalRangeError(s, DateTimeHyphen, strictDatetimeFields, v[:], err)
+	}
+	return dt, nil
+}
+
+// parseCanonicalYearMonth parses yyyy-MM as MonthHyphen.
+func parseCanonicalYearMonth[T text](s T) (YearMonth, error) {
+	var v [2]canonicalValue
+	if err := parseStrictFields(s, MonthHyphen, strictYearMonthFields, v[:]); err != nil {
+		return YearMonth{}, err
+	}
+	ym, err := YearMonth{Year: v[0].value, Month: v[1].value}.Valid()
+	if err != nil {
+		return YearMonth{}, canonicalRangeError(s, MonthHyphen, strictYearMonthFields, v[:], err)
+	}
+	return ym, nil
+}
+
+func parseStrictFields[T text](s T, f Format, fields []canonicalField, values []canonicalValue) error {
+	i, err := parseFields(s, 0, f, fields, values)
+	if err != nil {
+		return err
+	}
+	if i != len(s) {
+		return &ParseError{Input: string(s), Format: f, Offset: i, Err: errUnmatched}
+	}
+	return nil
+}
+
+// canonicalRangeError converts RangeError to ParseError at the offset of the field
+func canonicalRangeError[T text](s T, f Format, fields []canonicalField, values []canonicalValue, err error) error {
+	pe := &ParseError{Input: string(s), Format: f, Offset: -1, Err: err}
+	var re *RangeError
+	if errors.As(err, &re) {
+		pe.Field = re.Field
+		for n, field := range fields {
+			if field.name == re.Field {
+				pe.Offset = values[n].offset
+			}
+		}
+	}
+	return pe
+}
+
+// scanCanonicalDate parses as LocalDateRegex. 1 digit months and days are accepted, and trailing text is ignored.
+// the range is not checked.
+func scanCanonicalDate[T text](s T) (LocalDate, error) {
+	var v [3]canonicalValue
+	if _, err := parseFields(s, 0, DateHyphen, lenientDateFields, v[:]); err != nil {
+		return LocalDate{}, err
+	}
+	return LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value}, nil
+}
+
+// scanCanonicalDatetime parses as LocalDateTimeRegex. 1 digit fields are accepted, and trailing text is ignored.
+// the range is not checked.
+func scanCanonicalDatetime[T text](s T) (LocalDatetime, error) {
+	var v [6]canonicalValue
+	if _, err := parseFields(s, 0, DateTimeHyphen, lenientDatetimeFields, v[:]); err != nil {
+		return LocalDatetime{}, err
+	}
+	return LocalDatetime{
+		LocalDate: LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value},
+		LocalTime: LocalTime{Hour: v[3].value, Minute: v[4].value, Second: v[5].value},
+	}, nil
+}
+
+// scanCanonicalYearMonth parses yyyy-M, yyyy-MM and yyyyMM. trailing text such as the day is not accepted.
+// the range is not checked.
+func scanCanonicalYearMonth[T text](s T) (YearMonth, error) {
+	fields := compactYearMonthFields
+	if 4 < len(s) && s[4] == '-' {
+		fields = lenientYearMonthFields
+	}
+	var v [2]canonicalValue
+	if err := parseStrictFields(s, MonthHyphen, fields, v[:]); err != nil {
+		return YearMonth{}, err
+	}
+	return YearMonth{Year: v[0].value, Month: v[1].value}, nil
+}
+
+// scanCanonicalMonthDay parses --MM-dd and MM-dd. 1 digit months and days are also accepted.
+// the range is not checked.
+func scanCanonicalMonthDay[T text](s T) (MonthDay, error) {
+	i := 0
+	if 2 <= len(s) && s[0] == '-' && s[1] == '-' {
+		i = 2
+	}
+	var v [2]canonicalValue
+	i, err := parseFields(s, i, MonthDayISO, lenientMonthDayFields, v[:])
+	if err != nil {
+		return MonthDay{}, err
+	}
+	if i != len(s) {
+		return MonthDay{}, &ParseError{Input: string(s), Format: MonthDayISO, Offset: i, Err: errUnmatched}
+	}
+	return MonthDay{Month: v[0].value, Day: v[1].value}, nil
+}
+
+// jsonString returns the content of a json string.
+// ok is false when data is not a string or has escapes, then json.Unmarshal is needed.
+func jsonString(data []byte) ([]byte, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, false
+	}
+	content := data[1 : len(data)-1]
+	for _, c := range content {
+		if c == '\\' || c == '"' || c < ' ' {
+			return nil, false
+		}
+	}
+	return content, true
+}
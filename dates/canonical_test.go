@@ -0,0 +1,201 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParseCanonicalDatetime(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect LocalDatetime
+		field  string
+		offset int
+		rng    bool
+	}{
+		{title: "正常", input: "2024-10-16 09:05:03", expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "0時", input: "2024-10-16 00:00:00", expect: NewLocalDatetime(2024, 10, 16, 0, 0, 0)},
+		{title: "1桁の月", input: "2024-1-16 09:05:03", field: "month", offset: 5},
+		{title: "区切り文字が異なる", input: "2024/10/16 09:05:03", offset: 4},
+		{title: "時刻なし", input: "2024-10-16", offset: 10},
+		{title: "余分な文字", input: "2024-10-16 09:05:03.000", offset: 19},
+		{title: "数字でない年", input: "20x4-10-16 09:05:03", field: "year", offset: 0},
+		{title: "範囲外の月", input: "2024-13-16 09:05:03", field: "month", offset: 5, rng: true},
+		{title: "存在しない日", input: "2023-02-29 09:05:03", field: "day", offset: 8, rng: true},
+		{title: "範囲外の時", input: "2024-10-16 24:00:00", field: "hour", offset: 11, rng: true},
+		{title: "範囲外の秒", input: "2024-10-16 09:05:60", field: "second", offset: 17, rng: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := parseCanonicalDatetime(table.input)
+			bActual, bErr := parseCanonicalDatetime([]byte(table.input))
+			Equal(t, actual, bActual, "string, []byteで同じ結果")
+			Equal(t, err, bErr, "string, []byteで同じ結果")
+			if table.field == "" && table.offset == 0 {
+				Nil(t, err)
+				Equal(t, table.expect, actual)
+				return
+			}
+			var pe *ParseError
+			True(t, errors.As(err, &pe))
+			Equal(t, table.input, pe.Input)
+			Equal(t, DateTimeHyphen, pe.Format)
+			Equal(t, table.field, pe.Field)
+			Equal(t, table.offset, pe.Offset)
+			Equal(t, table.rng, errors.Is(err, ErrOutOfRangeDate))
+		})
+	}
+}
+
+func TestScanCanonical(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect LocalDate
+		err    bool
+	}{
+		{title: "正常", input: "2024-10-16", expect: LocalDate{Year: 2024, Month: 10, Day: 16}},
+		{title: "1桁の月日", input: "2024-1-6", expect: LocalDate{Year: 2024, Month: 1, Day: 6}},
+		{title: "後ろの文字は無視", input: "2024-10-16 09:05:03", expect: LocalDate{Year: 2024, Month: 10, Day: 16}},
+		{title: "3桁の月", input: "2024-100-16", err: true},
+		{title: "3桁の年", input: "202-10-16", err: true},
+		{title: "空", input: "", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := scanCanonicalDate(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrParse))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		ym, err := scanCanonicalYearMonth("202410")
+		Nil(t, err)
+		Equal(t, YearMonth{Year: 2024, Month: 10}, ym)
+		ym, err = scanCanonicalYearMonth("2024-1")
+		Nil(t, err)
+		Equal(t, YearMonth{Year: 2024, Month: 1}, ym)
+		_, err = scanCanonicalYearMonth("2024-1-16")
+		True(t, errors.Is(err, ErrParse), "YearMonthは日を許容しない")
+		_, err = scanCanonicalYearMonth("20241")
+		True(t, errors.Is(err, ErrParse), "区切りなしは月2桁のみ")
+		md, err := scanCanonicalMonthDay("--2-29")
+		Nil(t, err)
+		Equal(t, MonthDay{Month: 2, Day: 29}, md)
+		_, err = scanCanonicalMonthDay("02-29x")
+		True(t, errors.Is(err, ErrParse), "MonthDayは後ろの文字を許容しない")
+	}
+}
+
+func TestScan_Bytes(t *testing.T) {
+	var d LocalDate
+	Nil(t, d.Scan([]byte("2024-10-16")))
+	Equal(t, NewLocalDate(2024, 10, 16), d)
+
+	var dt LocalDatetime
+	Nil(t, dt.Scan([]byte("2024-10-16 09:05:03")))
+	Equal(t, NewLocalDatetime(2024, 10, 16, 9, 5, 3), dt)
+
+	var ym YearMonth
+	Nil(t, ym.Scan([]byte("2024-10")))
+	Equal(t, YearMonth{Year: 2024, Month: 10}, ym)
+
+	var md MonthDay
+	Nil(t, md.Scan([]byte("--10-16")))
+	Equal(t, MonthDay{Month: 10, Day: 16}, md)
+
+	b := []byte("2024-13-16")
+	err := d.Scan(b)
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	b[0] = 'x'
+	var se *ScanError
+	True(t, errors.As(err, &se))
+	Equal(t, []byte("2024-13-16"), se.Value, "driverが再利用しても影響しない")
+}
+
+func TestUnmarshalJSON_Escaped(t *testing.T) {
+	var d LocalDate
+	Nil(t, d.UnmarshalJSON([]byte(`"2024\u002d10-16"`)), "エスケープはjson.Unmarshalで処理")
+	Equal(t, NewLocalDate(2024, 10, 16), d)
+
+	var ym YearMonth
+	Nil(t, ym.UnmarshalJSON([]byte(`"2024\u002d10"`)))
+	Equal(t, YearMonth{Year: 2024, Month: 10}, ym)
+	True(t, errors.Is(ym.UnmarshalJSON([]byte(`"202410"`)), ErrParse))
+}
+
+func TestCanonical_Allocs(t *testing.T) {
+	var d LocalDate
+	var dt LocalDatetime
+	var dv, dtv, bv any = "2024-10-16", "2024-10-16 09:05:03", []byte("2024-10-16 09:05:03")
+	djson, dtjson := []byte(`"2024-10-16"`), []byte(`"2024-10-16 09:05:03"`)
+	for title, f := range map[string]func(){
+		"LocalDate.Scan":              func() { _ = d.Scan(dv) },
+		"LocalDatetime.Scan":          func() { _ = dt.Scan(dtv) },
+		"LocalDatetime.Scan []byte":   func() { _ = dt.Scan(bv) },
+		"LocalDate.UnmarshalJSON":     func() { _ = d.UnmarshalJSON(djson) },
+		"LocalDatetime.UnmarshalJSON": func() { _ = dt.UnmarshalJSON(dtjson) },
+		"LocalDatetime.UnmarshalFlag": func() { _ = dt.UnmarshalFlag("2024-10-16 09:05:03") },
+	} {
+		Equal(t, float64(0), testing.AllocsPerRun(100, f), title)
+	}
+}
+
+func BenchmarkLocalDate_Scan(b *testing.B) {
+	var d LocalDate
+	var v any = "2024-10-16"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := d.Scan(v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLocalDatetime_Scan(b *testing.B) {
+	var dt LocalDatetime
+	var v any = "2024-10-16 09:05:03"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := dt.Scan(v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLocalDatetime_ScanBytes(b *testing.B) {
+	var dt LocalDatetime
+	var v any = []byte("2024-10-16 09:05:03")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := dt.Scan(v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLocalDatetime_UnmarshalJSON(b *testing.B) {
+	var dt LocalDatetime
+	data := []byte(`"2024-10-16 09:05:03"`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := dt.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLocalDatetime_UnmarshalFlag(b *testing.B) {
+	var dt LocalDatetime
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := dt.UnmarshalFlag("2024-10-16 09:05:03"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
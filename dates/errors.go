@@ -0,0 +1,155 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseError failed to parse. errors.Is(err, ErrParse) is true.
+type ParseError struct {
+	Input  string
+	Format Format
+	// Field offending field. (year, month, day, hour, minute, second, ...) empty when unknown
+	Field string
+	// Offset byte offset of the offending part in Input. -1 when unknown
+	Offset int
+	Err    error
+}
+
+// Error to string
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v: %q", ErrParse, e.Input)
+	if e.Format != "" {
+		fmt.Fprintf(&b, " by %q", e.Format)
+	}
+	if e.Field != "" {
+		fmt.Fprintf(&b, ", %s", e.Field)
+	}
+	if e.Offset >= 0 {
+		fmt.Fprintf(&b, " at %d", e.Offset)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, ": %v", e.Err)
+	}
+	return b.String()
+}
+
+// Is matches ErrParse
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParse
+}
+
+// Unwrap returns the cause
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// RangeError field is out of range. errors.Is(err, ErrOutOfRangeDate) is true.
+type RangeError struct {
+	// Type type name. (localDate, localTime, yearMonth, ...)
+	Type  string
+	Field string
+	Value int64
+	Min   int64
+	Max   int64
+}
+
+// Error to string
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%v: %s %s: %d (%d..%d)", ErrOutOfRangeDate, e.Type, e.Field, e.Value, e.Min, e.Max)
+}
+
+// Is matches ErrOutOfRangeDate
+func (e *RangeError) Is(target error) bool {
+	return target == ErrOutOfRangeDate
+}
+
+// ScanError failed to scan. errors.Is(err, ErrScan) is true.
+type ScanError struct {
+	// Type type name of the destination. (localDate, localDatetime, ...)
+	Type  string
+	Value any
+	Err   error
+}
+
+// Error to string
+func (e *ScanError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%v: %s from %T", ErrScan, e.Type, e.Value)
+	}
+	return fmt.Sprintf("%v: %s from %T: %v", ErrScan, e.Type, e.Value, e.Err)
+}
+
+// Is matches ErrScan
+func (e *ScanError) Is(target error) bool {
+	return target == ErrScan
+}
+
+// Unwrap returns the cause
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	errNilValue        = errors.New("nil value")
+	errUnsupportedType = errors.New("unsupported type")
+	errUnmatched       = errors.New("unmatched")
+	errInfinity        = errors.New("infinity is not supported")
+	errWeekdayMismatch = errors.New("weekday does not match")
+)
+
+var _LayoutFieldMap = map[string]string{
+	"2006": "year", "06": "year",
+	"01": "month", "1": "month", "Jan": "month", "January": "month",
+	"02": "day", "2": "day", "_2": "day", "002": "day", "__2": "day",
+	"15": "hour", "03": "hour", "3": "hour",
+	"04": "minute", "4": "minute",
+	"05": "second", "5": "second",
+	"Mon": "weekday", "Monday": "weekday",
+	"PM": "ampm", "pm": "ampm",
+	"MST": "zone", "Z07:00": "zone", "-07:00": "zone", "-0700": "zone", "Z0700": "zone", "-07": "zone",
+}
+
+// newParseError converts an error of time.Parse to ParseError
+func newParseError(f Format, t string, err error) *ParseError {
+	pe := &ParseError{Input: t, Format: f, Offset: -1, Err: err}
+	var tpe *time.ParseError
+	if !errors.As(err, &tpe) {
+		return pe
+	}
+	pe.Field = _LayoutFieldMap[tpe.LayoutElem]
+	if strings.HasPrefix(tpe.LayoutElem, ".") || strings.HasPrefix(tpe.LayoutElem, ",") {
+		pe.Field = "fraction"
+	}
+	end := len(t) - len(tpe.ValueElem)
+	switch {
+	case strings.HasSuffix(tpe.Message, " out of range"):
+		pe.Field = strings.TrimSuffix(strings.TrimPrefix(tpe.Message, ": "), " out of range")
+		if tpe.LayoutElem != "" {
+			// 範囲外の場合, ValueElemは解析済みfieldの後ろを指すため, fieldの先頭まで戻す
+			start := end
+			for start > 0 && end-start < len(tpe.LayoutElem) && '0' <= t[start-1] && t[start-1] <= '9' {
+				start--
+			}
+			pe.Offset = start
+		}
+	default:
+		pe.Offset = end
+	}
+	return pe
+}
+
+// newScanError copies []byte value, since the driver may reuse it after Scan returns
+func newScanError(typ string, value any, err error) *ScanError {
+	if b, ok := value.([]byte); ok {
+		value = append([]byte(nil), b...)
+	}
+	return &ScanError{Type: typ, Value: value, Err: err}
+}
+
+func newRangeError(typ, field string, value uint, min, max uint) *RangeError {
+	return &RangeError{Type: typ, Field: field, Value: int64(value), Min: int64(min), Max: int64(max)}
+}
@@ -0,0 +1,113 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		format Format
+		input  string
+		field  string
+		offset int
+	}{
+		{title: "範囲外の月", format: DateHyphen, input: "2024-13-40", field: "month", offset: 5},
+		{title: "範囲外の日", format: DateHyphen, input: "2024-12-40", field: "day", offset: -1},
+		{title: "範囲外の時", format: DateTimeHyphen, input: "2024-10-16 25:00:00", field: "hour", offset: 11},
+		{title: "数字でない月", format: DateHyphen, input: "2024-1x-01", field: "month", offset: 5},
+		{title: "数字でない年", format: Date, input: "20x41016", field: "year", offset: 0},
+		{title: "余分な文字", format: DateHyphen, input: "2024-10-16x", field: "", offset: 10},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			_, err := ParseLocalDatetime(table.format, table.input)
+			True(t, errors.Is(err, ErrParse))
+			var pe *ParseError
+			True(t, errors.As(err, &pe))
+			Equal(t, table.input, pe.Input)
+			Equal(t, table.format, pe.Format)
+			Equal(t, table.field, pe.Field)
+			Equal(t, table.offset, pe.Offset)
+			NotNil(t, pe.Err)
+		})
+	}
+	_, err := ParseYearMonth(MonthHyphen, "2024-13")
+	var pe *ParseError
+	True(t, errors.As(err, &pe))
+	Equal(t, "month", pe.Field)
+	Equal(t, `failed to parse: "2024-13" by "2006-01", month at 5: parsing time "2024-13": month out of range`, err.Error())
+}
+
+func TestRangeError(t *testing.T) {
+	_, err := LocalDate{Year: 2024, Month: 13, Day: 1}.Valid()
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	var re *RangeError
+	True(t, errors.As(err, &re))
+	Equal(t, RangeError{Type: "localDate", Field: "month", Value: 13, Min: 1, Max: 12}, *re)
+	Equal(t, "date out of range: localDate month: 13 (1..12)", err.Error())
+
+	_, err = NewMonthDay(4, 31)
+	True(t, errors.As(err, &re))
+	Equal(t, RangeError{Type: "monthDay", Field: "day", Value: 31, Min: 1, Max: 30}, *re)
+}
+
+func TestScanError(t *testing.T) {
+	{
+		var d LocalDate
+		err := d.Scan("x2024-10-16")
+		True(t, errors.Is(err, ErrScan))
+		True(t, errors.Is(err, ErrParse), "解析できない場合ParseErrorを含む")
+		var se *ScanError
+		True(t, errors.As(err, &se))
+		Equal(t, "localDate", se.Type)
+		Equal(t, "x2024-10-16", se.Value)
+		var pe *ParseError
+		True(t, errors.As(err, &pe))
+		Equal(t, DateHyphen, pe.Format)
+	}
+	{
+		var dt LocalDatetime
+		err := dt.Scan(nil)
+		var se *ScanError
+		True(t, errors.As(err, &se))
+		Equal(t, "localDatetime", se.Type)
+		False(t, errors.Is(err, ErrParse))
+		Equal(t, "failed to scan: localDatetime from <nil>: nil value", err.Error())
+	}
+	{
+		var md MonthDay
+		err := md.Scan("02-30")
+		True(t, errors.Is(err, ErrScan))
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var d LocalDate
+	err := d.UnmarshalJSON([]byte(`"2024-13-01"`))
+	True(t, errors.Is(err, ErrUnmarshalJSON))
+	True(t, errors.Is(err, ErrParse))
+	var pe *ParseError
+	True(t, errors.As(err, &pe))
+	Equal(t, "month", pe.Field)
+
+	err = d.UnmarshalFlag("2024/10/01")
+	True(t, errors.Is(err, ErrUnmarshalFlag))
+	True(t, errors.Is(err, ErrParse), "既存のErrParseとの互換性")
+	True(t, errors.As(err, &pe))
+	Equal(t, 4, pe.Offset)
+
+	var dt LocalDatetime
+	err = dt.UnmarshalFlag("2024-10-01 00:60:00")
+	True(t, errors.Is(err, ErrUnmarshalFlag))
+	True(t, errors.As(err, &pe))
+	Equal(t, "minute", pe.Field)
+
+	var ym YearMonth
+	err = ym.UnmarshalJSON([]byte(`2024`))
+	True(t, errors.Is(err, ErrUnmarshalJSON))
+	False(t, errors.Is(err, ErrParse), "jsonの文字列でない場合")
+}
@@ -0,0 +1,249 @@
+package dates
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// quarter const
+const (
+	QuartersOfYear  uint = 4
+	MonthsOfQuarter uint = 3
+)
+
+// FiscalConfig fiscal year configuration.
+// The fiscal year is named after the calendar year in which it starts. (2024年度 = 2024-04-01 ~ 2025-03-31)
+type FiscalConfig struct {
+	StartMonth uint
+}
+
+// fiscal config list
+var (
+	CalendarFiscalConfig = FiscalConfig{StartMonth: 1}
+	JapanFiscalConfig    = FiscalConfig{StartMonth: 4}
+)
+
+// NewFiscalConfig new fiscalConfig
+func NewFiscalConfig(startMonth int) (FiscalConfig, error) {
+	if startMonth < int(MinMonthOfYear) || int(MaxMonthOfYear) < startMonth {
+		return FiscalConfig{}, fmt.Errorf("%w: fiscal start month: %d", ErrOutOfRangeDate, startMonth)
+	}
+	return FiscalConfig{StartMonth: uint(startMonth)}, nil
+}
+
+// startMonth zero value and out of range are treated as January
+func (c FiscalConfig) startMonth() uint {
+	if c.StartMonth < MinMonthOfYear || MaxMonthOfYear < c.StartMonth {
+		return MinMonthOfYear
+	}
+	return c.StartMonth
+}
+
+// IsCalendarYear fiscal year equals to calendar year?
+func (c FiscalConfig) IsCalendarYear() bool {
+	return c.startMonth() == MinMonthOfYear
+}
+
+// FiscalYear represents a fiscal year
+type FiscalYear struct {
+	Year   uint
+	Config FiscalConfig
+}
+
+// NewFiscalYear new fiscalYear
+func NewFiscalYear(year int, cfg FiscalConfig) FiscalYear {
+	if year < 1 {
+		return FiscalYear{Config: cfg}
+	}
+	return FiscalYear{Year: uint(year), Config: cfg}
+}
+
+// FirstMonth first yearMonth of the fiscal year
+func (fy FiscalYear) FirstMonth() YearMonth {
+	return YearMonth{Year: fy.Year, Month: fy.Config.startMonth()}
+}
+
+// LastMonth last yearMonth of the fiscal year
+func (fy FiscalYear) LastMonth() YearMonth {
+	return fy.FirstMonth().PlusMonths(int(MaxMonthOfYear) - 1)
+}
+
+// FirstDay first localDate of the fiscal year
+func (fy FiscalYear) FirstDay() LocalDate {
+	return fy.FirstMonth().Period().Start
+}
+
+// LastDay last localDate of the fiscal year
+func (fy FiscalYear) LastDay() LocalDate {
+	return fy.LastMonth().AtEndOfMonth()
+}
+
+// Period returns the period from the first day to the last day of the fiscal year.(both inclusive)
+func (fy FiscalYear) Period() LocalDatePeriod {
+	return LocalDatePeriod{Start: fy.FirstDay(), End: fy.LastDay()}
+}
+
+// Contains reports whether the localDate is in the fiscal year.
+func (fy FiscalYear) Contains(d LocalDate) bool {
+	return d.Between(fy.FirstDay(), fy.LastDay())
+}
+
+// Plus fiscalYear add years
+func (fy FiscalYear) Plus(years int) FiscalYear {
+	return NewFiscalYear(int(fy.Year)+years, fy.Config)
+}
+
+// Quarter returns the quarter(1~4) of the fiscal year.
+func (fy FiscalYear) Quarter(quarter int) (YearQuarter, error) {
+	return NewYearQuarter(int(fy.Year), quarter, fy.Config)
+}
+
+// Quarters returns all quarters of the fiscal year in order.
+func (fy FiscalYear) Quarters() []YearQuarter {
+	quarters := make([]YearQuarter, 0, QuartersOfYear)
+	for q := uint(1); q <= QuartersOfYear; q++ {
+		quarters = append(quarters, YearQuarter{Year: fy.Year, Quarter: q, Config: fy.Config})
+	}
+	return quarters
+}
+
+// Months returns all yearMonths of the fiscal year in order.
+func (fy FiscalYear) Months() []YearMonth {
+	return monthsFrom(fy.FirstMonth(), MaxMonthOfYear)
+}
+
+// String fiscal year to string. format: FY2024
+func (fy FiscalYear) String() string {
+	return "FY" + strconv.Itoa(int(fy.Year))
+}
+
+// JapaneseString fiscal year to japanese string. format: 2024年度
+func (fy FiscalYear) JapaneseString() string {
+	return strconv.Itoa(int(fy.Year)) + "年度"
+}
+
+// YearQuarter represents a quarter of a fiscal year.
+// When Config starts in January, it is a calendar quarter.
+type YearQuarter struct {
+	Year    uint
+	Quarter uint
+	Config  FiscalConfig
+}
+
+// NewYearQuarter new yearQuarter
+func NewYearQuarter(year, quarter int, cfg FiscalConfig) (YearQuarter, error) {
+	if year < 1 {
+		return YearQuarter{}, fmt.Errorf("%w: yearQuarter year: %d", ErrOutOfRangeDate, year)
+	}
+	if quarter < 1 || int(QuartersOfYear) < quarter {
+		return YearQuarter{}, fmt.Errorf("%w: yearQuarter quarter: %d", ErrOutOfRangeDate, quarter)
+	}
+	return YearQuarter{Year: uint(year), Quarter: uint(quarter), Config: cfg}, nil
+}
+
+// FiscalYear fiscal year of the quarter
+func (yq YearQuarter) FiscalYear() FiscalYear {
+	return FiscalYear{Year: yq.Year, Config: yq.Config}
+}
+
+// FirstMonth first yearMonth of the quarter
+func (yq YearQuarter) FirstMonth() YearMonth {
+	return yq.FiscalYear().FirstMonth().PlusMonths(int((yq.Quarter - 1) * MonthsOfQuarter))
+}
+
+// FirstDay first localDate of the quarter
+func (yq YearQuarter) FirstDay() LocalDate {
+	return yq.FirstMonth().Period().Start
+}
+
+// LastDay last localDate of the quarter
+func (yq YearQuarter) LastDay() LocalDate {
+	return yq.FirstMonth().PlusMonths(int(MonthsOfQuarter) - 1).AtEndOfMonth()
+}
+
+// Period returns the period from the first day to the last day of the quarter.(both inclusive)
+func (yq YearQuarter) Period() LocalDatePeriod {
+	return LocalDatePeriod{Start: yq.FirstDay(), End: yq.LastDay()}
+}
+
+// Contains reports whether the localDate is in the quarter.
+func (yq YearQuarter) Contains(d LocalDate) bool {
+	return d.Between(yq.FirstDay(), yq.LastDay())
+}
+
+// Months returns all yearMonths of the quarter in order.
+func (yq YearQuarter) Months() []YearMonth {
+	return monthsFrom(yq.FirstMonth(), MonthsOfQuarter)
+}
+
+// Plus yearQuarter add quarters
+func (yq YearQuarter) Plus(quarters int) YearQuarter {
+	total := int(yq.Year)*int(QuartersOfYear) + int(yq.Quarter) - 1 + quarters
+	if total < int(QuartersOfYear) {
+		return YearQuarter{Config: yq.Config}
+	}
+	return YearQuarter{
+		Year:    uint(total / int(QuartersOfYear)),
+		Quarter: uint(total%int(QuartersOfYear)) + 1,
+		Config:  yq.Config,
+	}
+}
+
+// Before reports whether the yearQuarter is before target.
+func (yq YearQuarter) Before(target YearQuarter) bool {
+	return yq.FirstDay().Before(target.FirstDay())
+}
+
+// After reports whether the yearQuarter is after target.
+func (yq YearQuarter) After(target YearQuarter) bool {
+	return yq.FirstDay().After(target.FirstDay())
+}
+
+// String quarter to string. format: FY2024 Q2 ( calendar quarter: 2024 Q2 )
+func (yq YearQuarter) String() string {
+	q := "Q" + strconv.Itoa(int(yq.Quarter))
+	if yq.Config.IsCalendarYear() {
+		return strconv.Itoa(int(yq.Year)) + " " + q
+	}
+	return yq.FiscalYear().String() + " " + q
+}
+
+// JapaneseString quarter to japanese string. format: 2024年度 第2四半期
+func (yq YearQuarter) JapaneseString() string {
+	return yq.FiscalYear().JapaneseString() + " 第" + strconv.Itoa(int(yq.Quarter)) + "四半期"
+}
+
+// FiscalYear returns the fiscal year which the localDate belongs to.
+// dates in year 0 (including zero localDate) are year 0, same as NewFiscalYear.
+func (d LocalDate) FiscalYear(cfg FiscalConfig) FiscalYear {
+	if d.Year == 0 {
+		return FiscalYear{Config: cfg}
+	}
+	year := d.Year
+	if d.Month < cfg.startMonth() {
+		year--
+	}
+	return FiscalYear{Year: year, Config: cfg}
+}
+
+// FiscalQuarter returns the fiscal quarter which the localDate belongs to.
+// dates in year 0 (including zero localDate) are year 0 and quarter 0, same as NewYearQuarter.
+func (d LocalDate) FiscalQuarter(cfg FiscalConfig) YearQuarter {
+	if d.Year == 0 {
+		return YearQuarter{Config: cfg}
+	}
+	monthsFromStart := (d.Month + MaxMonthOfYear - cfg.startMonth()) % MaxMonthOfYear
+	return YearQuarter{
+		Year:    d.FiscalYear(cfg).Year,
+		Quarter: monthsFromStart/MonthsOfQuarter + 1,
+		Config:  cfg,
+	}
+}
+
+func monthsFrom(start YearMonth, n uint) []YearMonth {
+	months := make([]YearMonth, 0, n)
+	for i := 0; i < int(n); i++ {
+		months = append(months, start.PlusMonths(i))
+	}
+	return months
+}
@@ -0,0 +1,97 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestNewFiscalConfig(t *testing.T) {
+	cfg, err := NewFiscalConfig(4)
+	Nil(t, err)
+	Equal(t, JapanFiscalConfig, cfg)
+
+	_, err = NewFiscalConfig(13)
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	True(t, FiscalConfig{}.IsCalendarYear(), "zero valueは暦年として扱う")
+}
+
+func TestLocalDate_FiscalYear(t *testing.T) {
+	for _, table := range []struct {
+		title         string
+		date          LocalDate
+		cfg           FiscalConfig
+		expectYear    uint
+		expectQuarter uint
+	}{
+		{title: "4月始まり.3月は前年度Q4", date: NewLocalDate(2025, 3, 31), cfg: JapanFiscalConfig, expectYear: 2024, expectQuarter: 4},
+		{title: "4月始まり.4月は当年度Q1", date: NewLocalDate(2024, 4, 1), cfg: JapanFiscalConfig, expectYear: 2024, expectQuarter: 1},
+		{title: "4月始まり.10月は当年度Q3", date: NewLocalDate(2024, 10, 16), cfg: JapanFiscalConfig, expectYear: 2024, expectQuarter: 3},
+		{title: "暦年.1月はQ1", date: NewLocalDate(2024, 1, 1), cfg: CalendarFiscalConfig, expectYear: 2024, expectQuarter: 1},
+		{title: "暦年.12月はQ4", date: NewLocalDate(2024, 12, 31), cfg: CalendarFiscalConfig, expectYear: 2024, expectQuarter: 4},
+		{title: "10月始まり.9月は前年度Q4", date: NewLocalDate(2024, 9, 30), cfg: FiscalConfig{StartMonth: 10}, expectYear: 2023, expectQuarter: 4},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			fy := table.date.FiscalYear(table.cfg)
+			Equal(t, table.expectYear, fy.Year)
+			True(t, fy.Contains(table.date))
+
+			q := table.date.FiscalQuarter(table.cfg)
+			Equal(t, table.expectYear, q.Year)
+			Equal(t, table.expectQuarter, q.Quarter)
+			True(t, q.Contains(table.date))
+		})
+	}
+}
+
+func TestLocalDate_FiscalYear_Zero(t *testing.T) {
+	Equal(t, FiscalYear{Config: JapanFiscalConfig}, LocalDate{}.FiscalYear(JapanFiscalConfig), "zero valueは0年度")
+	Equal(t, YearQuarter{Config: JapanFiscalConfig}, LocalDate{}.FiscalQuarter(JapanFiscalConfig), "zero valueは0年度の第0四半期")
+	d := LocalDate{Year: 0, Month: 2, Day: 1}
+	Equal(t, FiscalYear{Config: JapanFiscalConfig}, d.FiscalYear(JapanFiscalConfig), "0年は0年度. 桁あふれしない")
+	Equal(t, "FY0", d.FiscalYear(JapanFiscalConfig).String())
+	Equal(t, YearQuarter{Config: JapanFiscalConfig}, d.FiscalQuarter(JapanFiscalConfig), "0年は0年度の第0四半期")
+	Equal(t, FiscalYear{Config: JapanFiscalConfig}, LocalDate{Year: 0, Month: 4, Day: 1}.FiscalYear(JapanFiscalConfig))
+}
+
+func TestFiscalYear_Period(t *testing.T) {
+	fy := NewFiscalYear(2024, JapanFiscalConfig)
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 4, 1), End: NewLocalDate(2025, 3, 31)}, fy.Period())
+	Equal(t, "FY2024", fy.String())
+	Equal(t, "2024年度", fy.JapaneseString())
+	Equal(t, NewFiscalYear(2025, JapanFiscalConfig), fy.Plus(1))
+
+	months := fy.Months()
+	Len(t, months, 12)
+	Equal(t, YearMonth{Year: 2024, Month: 4}, months[0])
+	Equal(t, YearMonth{Year: 2025, Month: 3}, months[11])
+
+	quarters := fy.Quarters()
+	Len(t, quarters, 4)
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 7, 1), End: NewLocalDate(2024, 9, 30)}, quarters[1].Period())
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2025, 1, 1), End: NewLocalDate(2025, 3, 31)}, quarters[3].Period())
+}
+
+func TestYearQuarter(t *testing.T) {
+	q, err := NewYearQuarter(2024, 2, JapanFiscalConfig)
+	Nil(t, err)
+	Equal(t, "FY2024 Q2", q.String())
+	Equal(t, "2024年度 第2四半期", q.JapaneseString())
+	Equal(t, []YearMonth{{Year: 2024, Month: 7}, {Year: 2024, Month: 8}, {Year: 2024, Month: 9}}, q.Months())
+
+	next := q.Plus(3)
+	Equal(t, uint(2025), next.Year)
+	Equal(t, uint(1), next.Quarter)
+	True(t, q.Before(next))
+	True(t, next.After(q))
+	Equal(t, q, next.Plus(-3))
+
+	calendar, err := NewYearQuarter(2024, 4, CalendarFiscalConfig)
+	Nil(t, err)
+	Equal(t, "2024 Q4", calendar.String())
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 10, 1), End: NewLocalDate(2024, 12, 31)}, calendar.Period())
+
+	_, err = NewYearQuarter(2024, 5, CalendarFiscalConfig)
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+}
@@ -0,0 +1,253 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrIncorrectStep step is zero or mixes directions
+var ErrIncorrectStep = errors.New("step is zero or mixes forward and backward")
+
+// MonthEndPolicy decides the day when stepping by months reaches a shorter month.
+type MonthEndPolicy int
+
+// month end policy enums
+const (
+	// MonthEndClamp the day is clamped to the end of the month. 01-31 + 1 month ---> 02-28
+	MonthEndClamp MonthEndPolicy = iota
+	// MonthEndOverflow the overflowed days are carried to the next month. 01-31 + 1 month ---> 03-03
+	MonthEndOverflow
+	// MonthEndKeep when the start is the end of the month, the end of the month is kept. 02-28 + 1 month ---> 03-31
+	MonthEndKeep
+)
+
+// Step iteration step. each field is multiplied by the number of steps from the start.
+type Step struct {
+	Months   int
+	Days     int
+	Duration time.Duration
+	MonthEnd MonthEndPolicy
+}
+
+// StepDays step by days
+func StepDays(days int) Step {
+	return Step{Days: days}
+}
+
+// StepWeeks step by weeks
+func StepWeeks(weeks int) Step {
+	return Step{Days: weeks * DaysOfWeek}
+}
+
+// StepMonths step by months with the month end policy
+func StepMonths(months int, policy MonthEndPolicy) Step {
+	return Step{Months: months, MonthEnd: policy}
+}
+
+// StepDuration step by duration. for LocalDate, the duration must be a multiple of 24h.
+func StepDuration(d time.Duration) Step {
+	return Step{Duration: d}
+}
+
+// direction 1: forward, -1: backward, 0: incorrect
+func (s Step) direction() int {
+	forward := s.Months > 0 || s.Days > 0 || s.Duration > 0
+	backward := s.Months < 0 || s.Days < 0 || s.Duration < 0
+	switch {
+	case forward && !backward:
+		return 1
+	case backward && !forward:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Temporal LocalDate or LocalDatetime
+type Temporal interface {
+	LocalDate | LocalDatetime
+}
+
+// Range lazy range of LocalDate or LocalDatetime.
+// Nothing is allocated until values are iterated, and the n-th value is always computed from Start
+// so that stepping by months does not drift.
+type Range[T Temporal] struct {
+	start        T
+	end          T
+	step         Step
+	exclusiveEnd bool
+	filters      []func(T) bool
+}
+
+// NewRange new range from start to end (inclusive). to iterate in reverse, specify a negative step and start after end.
+func NewRange[T Temporal](start, end T, step Step) (Range[T], error) {
+	direction := step.direction()
+	if direction == 0 {
+		return Range[T]{}, fmt.Errorf("%w: %+v", ErrIncorrectStep, step)
+	}
+	if _, ok := any(start).(LocalDate); ok && step.Duration%(24*time.Hour) != 0 {
+		return Range[T]{}, fmt.Errorf("%w: duration of localDate must be a multiple of 24h. %v", ErrIncorrectStep, step.Duration)
+	}
+	if _, ok := any(start).(LocalDatetime); ok && step.Duration%time.Second != 0 {
+		return Range[T]{}, fmt.Errorf("%w: duration of localDatetime must be a multiple of 1s. %v", ErrIncorrectStep, step.Duration)
+	}
+	return Range[T]{start: start, end: end, step: step}, nil
+}
+
+// RangeDates new range of localDate
+func RangeDates(start, end LocalDate, step Step) (Range[LocalDate], error) {
+	return NewRange(start, end, step)
+}
+
+// RangeDatetimes new range of localDatetime
+func RangeDatetimes(start, end LocalDatetime, step Step) (Range[LocalDatetime], error) {
+	return NewRange(start, end, step)
+}
+
+// Dates range of the localDates in the period by step. (both inclusive)
+func (p LocalDatePeriod) Dates(step Step) (Range[LocalDate], error) {
+	return NewRange(p.Start, p.End, step)
+}
+
+// Exclusive the end is excluded from the range
+func (r Range[T]) Exclusive() Range[T] {
+	r.exclusiveEnd = true
+	return r
+}
+
+// Filter only values which f returns true are iterated. filters are combined by AND.
+func (r Range[T]) Filter(f func(T) bool) Range[T] {
+	filters := make([]func(T) bool, 0, len(r.filters)+1)
+	r.filters = append(append(filters, r.filters...), f)
+	return r
+}
+
+// Iterator returns a new iterator of the range
+func (r Range[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{r: r}
+}
+
+// ForEach calls f for each value in order. the iteration is terminated when f returns false.
+func (r Range[T]) ForEach(f func(T) bool) {
+	it := r.Iterator()
+	for it.Next() {
+		if !f(it.Value()) {
+			return
+		}
+	}
+}
+
+// Slice collects all values of the range
+func (r Range[T]) Slice() []T {
+	values := make([]T, 0)
+	r.ForEach(func(v T) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
+}
+
+// Iterator lazy iterator of Range
+//
+//	it := r.Iterator()
+//	for it.Next() {
+//	    v := it.Value()
+//	}
+type Iterator[T Temporal] struct {
+	r       Range[T]
+	n       int
+	current T
+	done    bool
+}
+
+// Next advances the iterator. false is returned when there are no more values.
+func (it *Iterator[T]) Next() bool {
+	for !it.done {
+		v, ok := stepFrom(it.r.start, it.r.step, it.n)
+		it.n++
+		if !ok || it.r.beyondEnd(v) {
+			it.done = true
+			return false
+		}
+		if it.r.accept(v) {
+			it.current = v
+			return true
+		}
+	}
+	return false
+}
+
+// Value current value of the iterator
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+func (r Range[T]) beyondEnd(v T) bool {
+	pos, end := position(v), position(r.end)
+	if r.step.direction() < 0 {
+		return pos < end || (r.exclusiveEnd && pos == end)
+	}
+	return pos > end || (r.exclusiveEnd && pos == end)
+}
+
+func (r Range[T]) accept(v T) bool {
+	for _, f := range r.filters {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// position comparable position of the value. localDate: epoch day, localDatetime: epoch second
+func position[T Temporal](v T) int64 {
+	switch t := any(v).(type) {
+	case LocalDate:
+		return t.EpochDay()
+	case LocalDatetime:
+		return t.epochSecond()
+	}
+	return 0
+}
+
+// stepFrom returns start + n * step. false is returned when the result is out of range.
+func stepFrom[T Temporal](start T, step Step, n int) (T, bool) {
+	var result T
+	switch t := any(start).(type) {
+	case LocalDate:
+		date := plusMonths(t, step.Months*n, step.MonthEnd)
+		date = addDays(date, step.Days*n+int(step.Duration/(24*time.Hour))*n)
+		result = any(date).(T)
+		return result, !date.IsZero()
+	case LocalDatetime:
+		date := plusMonths(t.LocalDate, step.Months*n, step.MonthEnd)
+		date = addDays(date, step.Days*n)
+		dt := LocalDatetime{LocalDate: date, LocalTime: t.LocalTime}.plusSeconds(int64(step.Duration/time.Second) * int64(n))
+		result = any(dt).(T)
+		return result, !dt.IsZero()
+	}
+	return result, false
+}
+
+func plusMonths(d LocalDate, months int, policy MonthEndPolicy) LocalDate {
+	if months == 0 {
+		return d
+	}
+	ym := YearMonthFromDate(d).PlusMonths(months)
+	if ym.IsZero() {
+		return LocalDate{}
+	}
+	switch policy {
+	case MonthEndOverflow:
+		return addDays(ym.Period().Start, int(d.Day)-1)
+	case MonthEndKeep:
+		if int(d.Day) == d.LengthOfMonth() {
+			return ym.AtEndOfMonth()
+		}
+	}
+	if length := ym.LengthOfMonth(); length < int(d.Day) {
+		return ym.AtEndOfMonth()
+	}
+	return LocalDate{Year: ym.Year, Month: ym.Month, Day: d.Day}
+}
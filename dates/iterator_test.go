@@ -0,0 +1,134 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestNewRange(t *testing.T) {
+	for _, table := range []struct {
+		title string
+		step  Step
+	}{
+		{title: "stepが0", step: Step{}},
+		{title: "前方と後方が混在", step: Step{Months: 1, Days: -1}},
+		{title: "24hの倍数でないduration", step: StepDuration(36 * time.Hour)},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			_, err := RangeDates(NewLocalDate(2024, 1, 1), NewLocalDate(2024, 2, 1), table.step)
+			True(t, errors.Is(err, ErrIncorrectStep))
+		})
+	}
+	_, err := RangeDatetimes(NewLocalDatetime(2024, 1, 1, 0, 0, 0), NewLocalDatetime(2024, 1, 2, 0, 0, 0), StepDuration(time.Millisecond))
+	True(t, errors.Is(err, ErrIncorrectStep), "1秒未満のduration")
+}
+
+func TestRange_Dates(t *testing.T) {
+	start, end := NewLocalDate(2024, 10, 28), NewLocalDate(2024, 11, 3)
+	{
+		r, err := RangeDates(start, end, StepDays(2))
+		Nil(t, err)
+		Equal(t, []LocalDate{
+			NewLocalDate(2024, 10, 28), NewLocalDate(2024, 10, 30), NewLocalDate(2024, 11, 1), NewLocalDate(2024, 11, 3),
+		}, r.Slice(), "endを含む")
+		Equal(t, []LocalDate{
+			NewLocalDate(2024, 10, 28), NewLocalDate(2024, 10, 30), NewLocalDate(2024, 11, 1),
+		}, r.Exclusive().Slice(), "endを含まない")
+	}
+	{
+		r, err := RangeDates(end, start, StepDays(-3))
+		Nil(t, err)
+		Equal(t, []LocalDate{NewLocalDate(2024, 11, 3), NewLocalDate(2024, 10, 31), NewLocalDate(2024, 10, 28)}, r.Slice(), "逆順")
+	}
+	{
+		r, err := LocalDatePeriod{Start: start, End: end}.Dates(StepDuration(24 * time.Hour))
+		Nil(t, err)
+		weekdays := r.Filter(func(d LocalDate) bool {
+			return d.Weekday() != time.Saturday && d.Weekday() != time.Sunday
+		}).Slice()
+		Equal(t, []LocalDate{
+			NewLocalDate(2024, 10, 28), NewLocalDate(2024, 10, 29), NewLocalDate(2024, 10, 30), NewLocalDate(2024, 10, 31), NewLocalDate(2024, 11, 1),
+		}, weekdays, "平日のみ")
+	}
+	{
+		r, err := RangeDates(start, LocalDate{Year: MaxYear, Month: 12, Day: 31}, StepWeeks(1))
+		Nil(t, err)
+		count := 0
+		r.ForEach(func(d LocalDate) bool {
+			count++
+			return count < 3
+		})
+		Equal(t, 3, count, "途中で打ち切れる")
+
+		it := r.Iterator()
+		True(t, it.Next())
+		Equal(t, start, it.Value())
+		True(t, it.Next())
+		Equal(t, NewLocalDate(2024, 11, 4), it.Value())
+	}
+}
+
+func TestRange_Months(t *testing.T) {
+	start, end := NewLocalDate(2023, 1, 31), NewLocalDate(2023, 5, 31)
+	for _, table := range []struct {
+		title  string
+		policy MonthEndPolicy
+		expect []LocalDate
+	}{
+		{
+			title:  "clamp.ずれが蓄積しない",
+			policy: MonthEndClamp,
+			expect: []LocalDate{NewLocalDate(2023, 1, 31), NewLocalDate(2023, 2, 28), NewLocalDate(2023, 3, 31), NewLocalDate(2023, 4, 30), NewLocalDate(2023, 5, 31)},
+		},
+		{
+			title:  "overflow",
+			policy: MonthEndOverflow,
+			expect: []LocalDate{NewLocalDate(2023, 1, 31), NewLocalDate(2023, 3, 3), NewLocalDate(2023, 3, 31), NewLocalDate(2023, 5, 1), NewLocalDate(2023, 5, 31)},
+		},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			r, err := RangeDates(start, end, StepMonths(1, table.policy))
+			Nil(t, err)
+			Equal(t, table.expect, r.Slice())
+		})
+	}
+	{
+		r, err := RangeDates(NewLocalDate(2023, 2, 28), NewLocalDate(2023, 5, 1), StepMonths(1, MonthEndKeep))
+		Nil(t, err)
+		Equal(t, []LocalDate{NewLocalDate(2023, 2, 28), NewLocalDate(2023, 3, 31), NewLocalDate(2023, 4, 30)}, r.Slice(), "月末が維持される")
+	}
+}
+
+func TestRange_Datetimes(t *testing.T) {
+	start, end := NewLocalDatetime(2024, 10, 16, 22, 30, 0), NewLocalDatetime(2024, 10, 17, 1, 0, 0)
+	{
+		r, err := RangeDatetimes(start, end, StepDuration(45*time.Minute))
+		Nil(t, err)
+		Equal(t, []LocalDatetime{
+			NewLocalDatetime(2024, 10, 16, 22, 30, 0),
+			NewLocalDatetime(2024, 10, 16, 23, 15, 0),
+			NewLocalDatetime(2024, 10, 17, 0, 0, 0),
+			NewLocalDatetime(2024, 10, 17, 0, 45, 0),
+		}, r.Slice())
+	}
+	{
+		r, err := RangeDatetimes(end, NewLocalDatetime(2024, 10, 16, 23, 0, 0), StepDuration(-time.Hour))
+		Nil(t, err)
+		Equal(t, []LocalDatetime{
+			NewLocalDatetime(2024, 10, 17, 1, 0, 0),
+			NewLocalDatetime(2024, 10, 17, 0, 0, 0),
+		}, r.Exclusive().Slice(), "逆順.endを含まない")
+	}
+	{
+		r, err := RangeDatetimes(NewLocalDatetime(2024, 1, 31, 9, 0, 0), NewLocalDatetime(2024, 3, 31, 9, 0, 0), StepMonths(1, MonthEndClamp))
+		Nil(t, err)
+		Equal(t, []LocalDatetime{
+			NewLocalDatetime(2024, 1, 31, 9, 0, 0),
+			NewLocalDatetime(2024, 2, 29, 9, 0, 0),
+			NewLocalDatetime(2024, 3, 31, 9, 0, 0),
+		}, r.Slice())
+	}
+}
@@ -0,0 +1,225 @@
+package dates
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// japanese date const
+const (
+	JapaneseDateRegex            = "^(明治|大正|昭和|平成|令和)(元|\\d{1,2})年(\\d{1,2})月(\\d{1,2})日(?:\\((.)\\))?$"
+	JapaneseDateAbbreviatedRegex = "^([MTSHR])(\\d{1,2})\\.(\\d{1,2})\\.(\\d{1,2})$"
+	FirstYearOfEra               = "元"
+)
+
+var (
+	japaneseDateRegexp            = regexp.MustCompile(JapaneseDateRegex)
+	japaneseDateAbbreviatedRegexp = regexp.MustCompile(JapaneseDateAbbreviatedRegex)
+)
+
+// Era japanese era (元号)
+type Era int
+
+// era enums
+const (
+	EraMeiji Era = iota + 1
+	EraTaisho
+	EraShowa
+	EraHeisei
+	EraReiwa
+)
+
+// Eras all eras in order
+var Eras = []Era{EraMeiji, EraTaisho, EraShowa, EraHeisei, EraReiwa}
+
+var _EraNameMap = map[Era]string{
+	EraMeiji:  "明治",
+	EraTaisho: "大正",
+	EraShowa:  "昭和",
+	EraHeisei: "平成",
+	EraReiwa:  "令和",
+}
+
+var _EraAbbreviationMap = map[Era]string{
+	EraMeiji:  "M",
+	EraTaisho: "T",
+	EraShowa:  "S",
+	EraHeisei: "H",
+	EraReiwa:  "R",
+}
+
+// 明治は改暦前(旧暦)の期間を含むが, グレゴリオ暦の1868-01-01からとする.
+var _EraStartMap = map[Era]LocalDate{
+	EraMeiji:  {Year: 1868, Month: 1, Day: 1},
+	EraTaisho: {Year: 1912, Month: 7, Day: 30},
+	EraShowa:  {Year: 1926, Month: 12, Day: 25},
+	EraHeisei: {Year: 1989, Month: 1, Day: 8},
+	EraReiwa:  {Year: 2019, Month: 5, Day: 1},
+}
+
+// String to string. (令和)
+func (e Era) String() string {
+	return _EraNameMap[e]
+}
+
+// end last day of the era. the last era ends at MaxYear.
+func (e Era) end() LocalDate {
+	for i, era := range Eras {
+		if era == e && i+1 < len(Eras) {
+			return addDays(Eras[i+1].Start(), -1)
+		}
+	}
+	return LocalDate{Year: MaxYear, Month: MaxMonthOfYear, Day: 31}
+}
+
+// Abbreviation abbreviation of the era. (R)
+func (e Era) Abbreviation() string {
+	return _EraAbbreviationMap[e]
+}
+
+// Start first day of the era
+func (e Era) Start() LocalDate {
+	return _EraStartMap[e]
+}
+
+// JapaneseDate date of japanese era (和暦). Year 1 is 元年.
+type JapaneseDate struct {
+	Era   Era
+	Year  uint
+	Month uint
+	Day   uint
+}
+
+// NewJapaneseDate new japaneseDate. returns error when the date is not in the era.
+//
+//	NewJapaneseDate(EraHeisei, 31, 5, 1) ---> error (令和元年5月1日)
+func NewJapaneseDate(era Era, year, month, day int) (JapaneseDate, error) {
+	start, ok := _EraStartMap[era]
+	if !ok {
+		return JapaneseDate{}, &RangeError{Type: "japaneseDate", Field: "era", Value: int64(era), Min: int64(EraMeiji), Max: int64(EraReiwa)}
+	}
+	end := era.end()
+	if lastYear := int(end.Year - start.Year + 1); year < 1 || lastYear < year {
+		return JapaneseDate{}, &RangeError{Type: "japaneseDate", Field: "year", Value: int64(year), Min: 1, Max: int64(lastYear)}
+	}
+	if month < int(MinMonthOfYear) || int(MaxMonthOfYear) < month {
+		return JapaneseDate{}, &RangeError{Type: "japaneseDate", Field: "month", Value: int64(month), Min: int64(MinMonthOfYear), Max: int64(MaxMonthOfYear)}
+	}
+	d := LocalDate{Year: start.Year + uint(year) - 1, Month: uint(month)}
+	if maxDay := daysInMonth(d.Year, d.Month); day < int(MinDayOfMonth) || int(maxDay) < day {
+		return JapaneseDate{}, &RangeError{Type: "japaneseDate", Field: "day", Value: int64(day), Min: int64(MinDayOfMonth), Max: int64(maxDay)}
+	}
+	d.Day = uint(day)
+	if d.Before(start) || d.After(end) {
+		// 元号の境界の年は, 日付で範囲を示す (yyyyMMdd)
+		return JapaneseDate{}, &RangeError{Type: "japaneseDate", Field: "date", Value: yyyyMMdd(d), Min: yyyyMMdd(start), Max: yyyyMMdd(end)}
+	}
+	return JapaneseDate{Era: era, Year: uint(year), Month: d.Month, Day: d.Day}, nil
+}
+
+// JapaneseDate converts to japaneseDate. returns error before 明治.
+func (d LocalDate) JapaneseDate() (JapaneseDate, error) {
+	for i := len(Eras) - 1; i >= 0; i-- {
+		era := Eras[i]
+		start := era.Start()
+		if d.Before(start) {
+			continue
+		}
+		return JapaneseDate{Era: era, Year: d.Year - start.Year + 1, Month: d.Month, Day: d.Day}, nil
+	}
+	return JapaneseDate{}, fmt.Errorf("%w: %v is before %s", ErrOutOfRangeDate, d, EraMeiji)
+}
+
+// LocalDate converts to localDate
+func (jd JapaneseDate) LocalDate() LocalDate {
+	if jd.IsZero() {
+		return LocalDate{}
+	}
+	return LocalDate{Year: jd.Era.Start().Year + jd.Year - 1, Month: jd.Month, Day: jd.Day}
+}
+
+// IsZero zero value
+func (jd JapaneseDate) IsZero() bool {
+	return jd.Era == 0 && jd.Year == 0 && jd.Month == 0 && jd.Day == 0
+}
+
+// YearString year with 元年. (元, 6)
+func (jd JapaneseDate) YearString() string {
+	if jd.Year == 1 {
+		return FirstYearOfEra
+	}
+	return strconv.Itoa(int(jd.Year))
+}
+
+// String to string. (令和6年10月16日(水), 令和元年5月1日(水))
+func (jd JapaneseDate) String() string {
+	if jd.IsZero() {
+		return ""
+	}
+	weekday := LocaleJa.weekdayName(int(jd.LocalDate().Weekday()), false)
+	return fmt.Sprintf("%s%s年%d月%d日(%s)", jd.Era, jd.YearString(), jd.Month, jd.Day, weekday)
+}
+
+// Abbreviated abbreviated string. (R6.10.16)
+func (jd JapaneseDate) Abbreviated() string {
+	if jd.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s%d.%d.%d", jd.Era.Abbreviation(), jd.Year, jd.Month, jd.Day)
+}
+
+// ParseJapaneseDate parse japaneseDate by string.
+// Both "令和6年10月16日(水)" and "R6.10.16" are accepted, and full-width digits are also accepted.
+// The weekday is optional, but returns error when it does not match the date.
+func ParseJapaneseDate(t string) (JapaneseDate, error) {
+	normalized := normalizeFullWidth(strings.TrimSpace(t))
+	if group := japaneseDateRegexp.FindStringSubmatch(normalized); len(group) == 6 {
+		era := eraOf(group[1], _EraNameMap)
+		year := 1
+		if group[2] != FirstYearOfEra {
+			year, _ = strconv.Atoi(group[2])
+		}
+		month, _ := strconv.Atoi(group[3])
+		day, _ := strconv.Atoi(group[4])
+		jd, err := NewJapaneseDate(era, year, month, day)
+		if err != nil {
+			return JapaneseDate{}, &ParseError{Input: t, Offset: -1, Err: err}
+		}
+		if group[5] != "" && group[5] != LocaleJa.weekdayName(int(jd.LocalDate().Weekday()), false) {
+			return JapaneseDate{}, &ParseError{Input: t, Field: "weekday", Offset: -1, Err: errWeekdayMismatch}
+		}
+		return jd, nil
+	}
+	if group := japaneseDateAbbreviatedRegexp.FindStringSubmatch(normalized); len(group) == 5 {
+		era := eraOf(group[1], _EraAbbreviationMap)
+		year, _ := strconv.Atoi(group[2])
+		month, _ := strconv.Atoi(group[3])
+		day, _ := strconv.Atoi(group[4])
+		jd, err := NewJapaneseDate(era, year, month, day)
+		if err != nil {
+			return JapaneseDate{}, &ParseError{Input: t, Offset: -1, Err: err}
+		}
+		return jd, nil
+	}
+	return JapaneseDate{}, &ParseError{Input: t, Offset: -1, Err: errUnmatched}
+}
+
+// ParseLocalDateFromJapanese parse localDate by japanese date string
+func ParseLocalDateFromJapanese(t string) (LocalDate, error) {
+	jd, err := ParseJapaneseDate(t)
+	if err != nil {
+		return LocalDate{}, err
+	}
+	return jd.LocalDate(), nil
+}
+
+func eraOf(name string, names map[Era]string) Era {
+	for era, n := range names {
+		if n == name {
+			return era
+		}
+	}
+	return 0
+}
@@ -0,0 +1,137 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDate_JapaneseDate(t *testing.T) {
+	for _, table := range []struct {
+		title       string
+		input       LocalDate
+		expect      JapaneseDate
+		str         string
+		abbreviated string
+	}{
+		{
+			title:       "令和",
+			input:       NewLocalDate(2024, 10, 16),
+			expect:      JapaneseDate{Era: EraReiwa, Year: 6, Month: 10, Day: 16},
+			str:         "令和6年10月16日(水)",
+			abbreviated: "R6.10.16",
+		},
+		{
+			title:       "令和元年の初日",
+			input:       NewLocalDate(2019, 5, 1),
+			expect:      JapaneseDate{Era: EraReiwa, Year: 1, Month: 5, Day: 1},
+			str:         "令和元年5月1日(水)",
+			abbreviated: "R1.5.1",
+		},
+		{
+			title:       "平成の最終日",
+			input:       NewLocalDate(2019, 4, 30),
+			expect:      JapaneseDate{Era: EraHeisei, Year: 31, Month: 4, Day: 30},
+			str:         "平成31年4月30日(火)",
+			abbreviated: "H31.4.30",
+		},
+		{
+			title:       "昭和の最終日",
+			input:       NewLocalDate(1989, 1, 7),
+			expect:      JapaneseDate{Era: EraShowa, Year: 64, Month: 1, Day: 7},
+			str:         "昭和64年1月7日(土)",
+			abbreviated: "S64.1.7",
+		},
+		{
+			title:       "大正元年",
+			input:       NewLocalDate(1912, 7, 30),
+			expect:      JapaneseDate{Era: EraTaisho, Year: 1, Month: 7, Day: 30},
+			str:         "大正元年7月30日(火)",
+			abbreviated: "T1.7.30",
+		},
+		{
+			title:       "明治の最終日",
+			input:       NewLocalDate(1912, 7, 29),
+			expect:      JapaneseDate{Era: EraMeiji, Year: 45, Month: 7, Day: 29},
+			str:         "明治45年7月29日(月)",
+			abbreviated: "M45.7.29",
+		},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := table.input.JapaneseDate()
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+			Equal(t, table.str, actual.String())
+			Equal(t, table.abbreviated, actual.Abbreviated())
+			Equal(t, table.input, actual.LocalDate())
+		})
+	}
+	_, err := NewLocalDate(1867, 12, 31).JapaneseDate()
+	True(t, errors.Is(err, ErrOutOfRangeDate), "明治より前")
+}
+
+func TestNewJapaneseDate(t *testing.T) {
+	_, err := NewJapaneseDate(EraHeisei, 31, 5, 1)
+	True(t, errors.Is(err, ErrOutOfRangeDate), "平成31年5月1日は存在しない")
+	_, err = NewJapaneseDate(EraReiwa, 1, 4, 30)
+	True(t, errors.Is(err, ErrOutOfRangeDate), "令和元年4月30日は存在しない")
+	_, err = NewJapaneseDate(EraReiwa, 6, 2, 30)
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	_, err = NewJapaneseDate(EraReiwa, 0, 5, 1)
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	_, err = NewJapaneseDate(Era(0), 1, 5, 1)
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	_, err = NewJapaneseDate(EraTaisho, 16, 1, 1)
+	True(t, errors.Is(err, ErrOutOfRangeDate), "大正は15年まで")
+	jd, err := NewJapaneseDate(EraReiwa, 6, 10, 16)
+	Nil(t, err)
+	Equal(t, JapaneseDate{Era: EraReiwa, Year: 6, Month: 10, Day: 16}, jd)
+
+	var rangeErr *RangeError
+	_, err = NewJapaneseDate(EraHeisei, 31, 5, 1)
+	True(t, errors.As(err, &rangeErr))
+	Equal(t, RangeError{Type: "japaneseDate", Field: "date", Value: 20190501, Min: 19890108, Max: 20190430}, *rangeErr)
+	_, err = NewJapaneseDate(EraReiwa, 6, 2, 30)
+	True(t, errors.As(err, &rangeErr))
+	Equal(t, RangeError{Type: "japaneseDate", Field: "day", Value: 30, Min: 1, Max: 29}, *rangeErr)
+}
+
+func TestParseJapaneseDate(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect LocalDate
+		err    bool
+	}{
+		{title: "曜日あり", input: "令和6年10月16日(水)", expect: NewLocalDate(2024, 10, 16)},
+		{title: "曜日なし", input: "令和6年10月16日", expect: NewLocalDate(2024, 10, 16)},
+		{title: "元年", input: "令和元年5月1日", expect: NewLocalDate(2019, 5, 1)},
+		{title: "全角数字と全角括弧", input: "令和６年１０月１６日（水）", expect: NewLocalDate(2024, 10, 16)},
+		{title: "略記", input: "R6.10.16", expect: NewLocalDate(2024, 10, 16)},
+		{title: "略記のゼロ埋め", input: "H01.01.08", expect: NewLocalDate(1989, 1, 8)},
+		{title: "全角の略記", input: "Ｓ６４．１．７", expect: NewLocalDate(1989, 1, 7)},
+		{title: "曜日が異なる", input: "令和6年10月16日(木)", err: true},
+		{title: "元号の範囲外", input: "平成31年5月1日", err: true},
+		{title: "不明な元号", input: "X6.10.16", err: true},
+		{title: "空文字", input: "", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := ParseLocalDateFromJapanese(table.input)
+			if table.err {
+				var parseErr *ParseError
+				True(t, errors.As(err, &parseErr))
+				Equal(t, table.input, parseErr.Input)
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	_, err := ParseJapaneseDate("平成31年5月1日")
+	True(t, errors.Is(err, ErrOutOfRangeDate), "元号の範囲外はRangeErrorを含む")
+	var parseErr *ParseError
+	_, err = ParseJapaneseDate("令和6年10月16日(木)")
+	True(t, errors.As(err, &parseErr))
+	Equal(t, "weekday", parseErr.Field)
+}
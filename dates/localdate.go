@@ -5,36 +5,42 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"time"
 )
 
+// regex const
+const (
+	// Deprecated: Scan no longer uses regular expressions. kept for compatibility.
+	LocalDateRegex = "^(\\d{4})-(\\d{1,2})-(\\d{1,2})"
+	// Deprecated: Scan no longer uses regular expressions. kept for compatibility.
+	LocalDateTimeRegex = "^(\\d{4})-(\\d{1,2})-(\\d{1,2})\\ (\\d{1,2}):(\\d{1,2}):(\\d{1,2})"
+)
+
 // time const
 const (
-	LocalDateRegex                   = "^(\\d{4})-(\\d{1,2})-(\\d{1,2})"
-	LocalDateTimeRegex               = "^(\\d{4})-(\\d{1,2})-(\\d{1,2})\\ (\\d{1,2}):(\\d{1,2}):(\\d{1,2})"
-	MaxYear            uint          = 999999999 //mysqlの最大値とは異なるため注意.
-	MinYear            uint          = 0
-	MaxMonthOfYear     uint          = 12
-	MinMonthOfYear     uint          = 1
-	MaxDayOfMonth      uint          = 31
-	MinDayOfMonth      uint          = 1
-	MaxHourOfDay       uint          = 23
-	MinHourOfDay       uint          = 1
-	MaxMinuteOfHour    uint          = 59
-	MinMinuteOfHour    uint          = 0
-	MaxSecOfMinute     uint          = 59
-	MinSecOfMinute     uint          = 0
-	MinDuration        time.Duration = -1 << 63
-	MaxDuration        time.Duration = 1<<63 - 1
-	FirstUnixInAD      int64         = -62135596800
+	MaxYear         uint          = 999999999 //mysqlの最大値とは異なるため注意.
+	MinYear         uint          = 0
+	MaxMonthOfYear  uint          = 12
+	MinMonthOfYear  uint          = 1
+	MaxDayOfMonth   uint          = 31
+	MinDayOfMonth   uint          = 1
+	MaxHourOfDay    uint          = 23
+	MinHourOfDay    uint          = 0
+	MaxMinuteOfHour uint          = 59
+	MinMinuteOfHour uint          = 0
+	MaxSecOfMinute  uint          = 59
+	MinSecOfMinute  uint          = 0
+	MinDuration     time.Duration = -1 << 63
+	MaxDuration     time.Duration = 1<<63 - 1
+	FirstUnixInAD   int64         = -62135596800
 )
 
 // format list
 const (
 	Month           = Format("200601")
 	MonthHyphen     = Format("2006-01")
+	MonthDayISO     = Format("--01-02")
 	DateAbbreviated = Format("060102")
 	Date            = Format("20060102")
 	DateHyphen      = Format("2006-01-02")
@@ -128,16 +134,16 @@ type LocalDate struct {
 	Day   uint
 }
 
-// Valid valid localDate
+// Valid valid localDate. the day is checked by the length of the month. (2023-02-29 is invalid)
 func (d LocalDate) Valid() (LocalDate, error) {
 	if d.Year < MinYear || MaxYear < d.Year {
-		return d, fmt.Errorf("%w: localDate out of range ! year :%d", ErrOutOfRangeDate, d.Year)
+		return d, newRangeError("localDate", "year", d.Year, MinYear, MaxYear)
 	}
 	if d.Month < MinMonthOfYear || MaxMonthOfYear < d.Month {
-		return d, fmt.Errorf("%w: localDate out of range ! month: %d", ErrOutOfRangeDate, d.Month)
+		return d, newRangeError("localDate", "month", d.Month, MinMonthOfYear, MaxMonthOfYear)
 	}
-	if d.Day < MinDayOfMonth || MaxDayOfMonth < d.Day {
-		return d, fmt.Errorf("%w: localDate out of range ! day: %d", ErrOutOfRangeDate, d.Day)
+	if maxDay := daysInMonth(d.Year, d.Month); d.Day < MinDayOfMonth || maxDay < d.Day {
+		return d, newRangeError("localDate", "day", d.Day, MinDayOfMonth, maxDay)
 	}
 	return d, nil
 }
@@ -148,38 +154,58 @@ func (d LocalDate) Value() (driver.Value, error) {
 	return year + "-" + month + "-" + day, nil
 }
 
-// Scan for go-sql-driver
+// Scan for go-sql-driver. values are converted as follows.
+//
+//	string, []byte                   yyyy-MM-dd. parsed without allocation, and the trailing is ignored.
+//	time.Time                        the date of the wall clock in its location.
+//	integer                          unix seconds in UTC. (use IntLocalDate for yyyyMMdd)
+//	float                            unix seconds in UTC. the fraction is floored.
+//	others                           converted by driver.String
+//
+// integers and floats of any size are accepted. (int, int32, uint16, float32, ...) unsigned integers above math.MaxInt64 are out of range.
+// impossible dates are errors, and the zero date 0000-00-00 of MySQL is the zero localDate.
+// use LenientLocalDate to normalize impossible dates.
 func (d *LocalDate) Scan(value interface{}) error {
 	if d == nil || value == nil {
-		return fmt.Errorf("%w: nil value %v", ErrScan, value)
-	}
-	if sv, ce := driver.String.ConvertValue(value); ce == nil {
-		if v, ok := sv.(string); ok {
-			groups, ge := groupSubMatch(v, LocalDateRegex)
-			if ge != nil {
-				return fmt.Errorf("%w: failed to convert localDate! %v", ErrScan, ge.Error())
-			} else if len(groups) < 4 {
-				return fmt.Errorf("%w: failed to convert localDate! ( in grouping ) len: %d", ErrScan, len(groups))
-			}
-			year, ye := strconv.Atoi(groups[1])
-			month, me := strconv.Atoi(groups[2])
-			day, de := strconv.Atoi(groups[3])
-			if ye != nil || me != nil || de != nil {
-				return fmt.Errorf("%w: failed to convert localDate! groups [ %s, %s, %s ]", ErrScan, groups[1], groups[2], groups[3])
-			}
-			*d = LocalDate{Year: uint(year), Month: uint(month), Day: uint(day)}
-			return nil
-		}
+		return &ScanError{Type: "localDate", Value: value, Err: errNilValue}
 	}
-	return fmt.Errorf("%w: failed to scan localDate", ErrScan)
+	date, err := scanLocalDate("localDate", value, strictLocalDate)
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
 }
 
-func groupSubMatch(target, regex string) ([]string, error) {
-	reg, err := regexp.Compile(regex)
+// scanLocalDate converts the value by the table of LocalDate.Scan, and resolves impossible dates
+func scanLocalDate(typ string, value any, resolve func(LocalDate) (LocalDate, error)) (LocalDate, error) {
+	var date LocalDate
+	var err error
+	switch v := value.(type) {
+	case string:
+		date, err = scanCanonicalDate(v)
+	case []byte:
+		date, err = scanCanonicalDate(v)
+	default:
+		numeric, ok, ne := scanNumeric(typ, value)
+		if ok {
+			date, err = numeric.LocalDate, ne
+			break
+		}
+		sv, ce := driver.String.ConvertValue(value)
+		str, ok := sv.(string)
+		if ce != nil || !ok {
+			return LocalDate{}, &ScanError{Type: typ, Value: value, Err: errUnsupportedType}
+		}
+		date, err = scanCanonicalDate(str)
+	}
+	if err == nil {
+		date, err = resolve(date)
+	}
 	if err != nil {
-		return make([]string, 0), err
+		return LocalDate{}, newScanError(typ, value, err)
 	}
-	return reg.FindStringSubmatch(target), nil
+	return date, nil
 }
 
 // String to string
@@ -293,13 +319,19 @@ func (d *LocalDate) UnmarshalJSON(data []byte) error {
 	if d == nil || len(data) == 0 {
 		return fmt.Errorf("%w: localDate, receiver is nil or data len is 0", ErrUnmarshalJSON)
 	}
-	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("%w: failed to unmarshal localDate, err: %v", ErrUnmarshalJSON, err)
+	var date LocalDate
+	var err error
+	if str, ok := jsonString(data); ok {
+		date, err = parseCanonicalDate(str)
+	} else {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: failed to unmarshal localDate, err: %w", ErrUnmarshalJSON, err)
+		}
+		date, err = parseCanonicalDate(str)
 	}
-	date, err := ParseLocalDate(DateHyphen, str)
 	if err != nil {
-		return fmt.Errorf("%w: failed to parse localDate, err: %v", ErrUnmarshalJSON, err)
+		return fmt.Errorf("%w: failed to parse localDate, err: %w", ErrUnmarshalJSON, err)
 	}
 	*d = date
 	return nil
@@ -309,9 +341,9 @@ func (d *LocalDate) UnmarshalFlag(s string) error {
 	if d == nil || len(s) == 0 {
 		return fmt.Errorf("%w: localDate. receiver is nil or data len is 0", ErrUnmarshalFlag)
 	}
-	date, err := ParseLocalDate(DateHyphen, s)
+	date, err := parseCanonicalDate(s)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: localDate, err: %w", ErrUnmarshalFlag, err)
 	}
 	*d = date
 	return nil
@@ -332,7 +364,7 @@ func ParseLocalDate(f Format, t string) (LocalDate, error) {
 
 	tm, err := time.ParseInLocation(f.String(), t, loc)
 	if err != nil {
-		return LocalDate{}, fmt.Errorf("%w: %v", ErrParse, err)
+		return LocalDate{}, newParseError(f, t, err)
 	}
 	return LocalDateFromTime(tm), nil
 }
@@ -342,7 +374,12 @@ type (
 		Start LocalDate
 		End   LocalDate
 	}
-	LocalDatePeriods []LocalDatePeriod
+	LocalDatePeriods    []LocalDatePeriod
+	LocalDatetimePeriod struct {
+		Start LocalDatetime
+		End   LocalDatetime
+	}
+	LocalDatetimePeriods []LocalDatetimePeriod
 )
 
 func DivideDatePeriod(start, end LocalDate, day int) (LocalDatePeriods, error) {
@@ -390,13 +427,13 @@ type LocalTime struct {
 // Valid validate localTime
 func (t LocalTime) Valid() (LocalTime, error) {
 	if t.Hour < MinHourOfDay || MaxHourOfDay < t.Hour {
-		return t, fmt.Errorf("%w: localTime hour :%d", ErrOutOfRangeDate, t.Hour)
+		return t, newRangeError("localTime", "hour", t.Hour, MinHourOfDay, MaxHourOfDay)
 	}
 	if t.Minute < MinMinuteOfHour || MaxMinuteOfHour < t.Minute {
-		return t, fmt.Errorf("%w: localTime minute: %d", ErrOutOfRangeDate, t.Minute)
+		return t, newRangeError("localTime", "minute", t.Minute, MinMinuteOfHour, MaxMinuteOfHour)
 	}
 	if t.Second < MinSecOfMinute || MaxSecOfMinute < t.Second {
-		return t, fmt.Errorf("%w: localTime second: %d", ErrOutOfRangeDate, t.Second)
+		return t, newRangeError("localTime", "second", t.Second, MinSecOfMinute, MaxSecOfMinute)
 	}
 	return t, nil
 }
@@ -460,37 +497,58 @@ func (dt LocalDatetime) String() string {
 	return val.(string)
 }
 
-// Scan for go-sql-driver
+// Scan for go-sql-driver. values are converted as follows.
+//
+//	string, []byte                   yyyy-MM-dd HH:mm:ss. parsed without allocation, and the trailing is ignored.
+//	time.Time                        the wall clock in its location. nanoseconds are truncated.
+//	integer                          unix seconds in UTC
+//	float                            unix seconds in UTC. the fraction is floored. (-1.5 is 1969-12-31 23:59:58)
+//	others                           converted by driver.String
+//
+// integers and floats of any size are accepted. (int, int32, uint16, float32, ...) unsigned integers above math.MaxInt64 are out of range.
+// impossible datetimes are errors, and the zero datetime 0000-00-00 00:00:00 of MySQL is the zero localDatetime.
+// use LenientLocalDatetime to normalize impossible datetimes.
 func (dt *LocalDatetime) Scan(value interface{}) error {
 	if dt == nil || value == nil {
-		return fmt.Errorf("%w: nil value %v", ErrScan, value)
-	}
-	if sv, ce := driver.String.ConvertValue(value); ce == nil {
-		if v, ok := sv.(string); ok {
-			groups, ge := groupSubMatch(v, LocalDateTimeRegex)
-			if ge != nil {
-				return fmt.Errorf("%w: localDatetime %v", ErrScan, ge)
-			} else if len(groups) < 7 {
-				return fmt.Errorf("%w: localDatetime (in grouping) len: %v", ErrScan, strconv.Itoa(len(groups)))
-			}
-			y, ye := strconv.Atoi(groups[1])
-			m, me := strconv.Atoi(groups[2])
-			d, de := strconv.Atoi(groups[3])
-			h, he := strconv.Atoi(groups[4])
-			min, minErr := strconv.Atoi(groups[5])
-			sec, se := strconv.Atoi(groups[6])
-
-			if ye != nil || me != nil || de != nil || he != nil || minErr != nil || se != nil {
-				return fmt.Errorf("%w: localDatetime groups: %v", ErrScan, groups)
-			}
-			*dt = LocalDatetime{
-				LocalDate: LocalDate{Year: uint(y), Month: uint(m), Day: uint(d)},
-				LocalTime: LocalTime{Hour: uint(h), Minute: uint(min), Second: uint(sec)},
-			}
-			return nil
+		return &ScanError{Type: "localDatetime", Value: value, Err: errNilValue}
+	}
+	datetime, err := scanLocalDatetime("localDatetime", value, strictLocalDatetime)
+	if err != nil {
+		return err
+	}
+	*dt = datetime
+	return nil
+}
+
+// scanLocalDatetime converts the value by the table of LocalDatetime.Scan, and resolves impossible datetimes
+func scanLocalDatetime(typ string, value any, resolve func(LocalDatetime) (LocalDatetime, error)) (LocalDatetime, error) {
+	var datetime LocalDatetime
+	var err error
+	switch v := value.(type) {
+	case string:
+		datetime, err = scanCanonicalDatetime(v)
+	case []byte:
+		datetime, err = scanCanonicalDatetime(v)
+	default:
+		numeric, ok, ne := scanNumeric(typ, value)
+		if ok {
+			datetime, err = numeric, ne
+			break
+		}
+		sv, ce := driver.String.ConvertValue(value)
+		str, ok := sv.(string)
+		if ce != nil || !ok {
+			return LocalDatetime{}, &ScanError{Type: typ, Value: value, Err: errUnsupportedType}
 		}
+		datetime, err = scanCanonicalDatetime(str)
 	}
-	return fmt.Errorf("%w: localDatetime", ErrScan)
+	if err == nil {
+		datetime, err = resolve(datetime)
+	}
+	if err != nil {
+		return LocalDatetime{}, newScanError(typ, value, err)
+	}
+	return datetime, nil
 }
 
 // MarshalJSON for json return format: yyyy-MM-dd hh:mm:ss
@@ -512,22 +570,28 @@ func (dt *LocalDatetime) UnmarshalJSON(data []byte) error {
 	if dt == nil || len(data) == 0 {
 		return fmt.Errorf("%w: localDatetime. receiver is nil or data len is 0", ErrUnmarshalJSON)
 	}
-	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("%w: failed to unmarshal localDatetime. err: %v", ErrUnmarshalJSON, err)
+	var datetime LocalDatetime
+	var err error
+	if str, ok := jsonString(data); ok {
+		datetime, err = parseCanonicalDatetime(str)
+	} else {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: failed to unmarshal localDatetime. err: %w", ErrUnmarshalJSON, err)
+		}
+		datetime, err = parseCanonicalDatetime(str)
 	}
-	time, err := time.ParseInLocation(DateTimeHyphen.String(), str, UTC.Location())
 	if err != nil {
-		return fmt.Errorf("%w: failed to parse localDatetime, err: %v", ErrUnmarshalJSON, err)
+		return fmt.Errorf("%w: failed to parse localDatetime, err: %w", ErrUnmarshalJSON, err)
 	}
-	*dt = LocalDatetimeFromTime(time)
+	*dt = datetime
 	return nil
 }
 
 func (dt *LocalDatetime) UnmarshalFlag(s string) error {
-	datetime, err := ParseLocalDatetime(DateTimeHyphen, s)
+	datetime, err := parseCanonicalDatetime(s)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: localDatetime, err: %w", ErrUnmarshalFlag, err)
 	}
 	*dt = datetime
 	return nil
@@ -603,6 +667,17 @@ func (dt LocalDatetime) AddDate(year, month, day int) LocalDatetime {
 	return LocalDatetimeFromTime(addedTime)
 }
 
+// Valid valid localDatetime
+func (dt LocalDatetime) Valid() (LocalDatetime, error) {
+	if _, err := dt.LocalDate.Valid(); err != nil {
+		return dt, err
+	}
+	if _, err := dt.LocalTime.Valid(); err != nil {
+		return dt, err
+	}
+	return dt, nil
+}
+
 // IsZero localDatetime is zero?
 func (dt LocalDatetime) IsZero() bool {
 	return dt.LocalDate.IsZero() && dt.LocalTime.IsZero()
@@ -649,7 +724,7 @@ func ParseLocalDatetime(f Format, t string) (LocalDatetime, error) {
 
 	tm, err := time.ParseInLocation(f.String(), t, loc)
 	if err != nil {
-		return LocalDatetime{}, fmt.Errorf("%w: err: %v", ErrParse, err)
+		return LocalDatetime{}, newParseError(f, t, err)
 	}
 	return LocalDatetimeFromTime(tm), nil
 }
@@ -0,0 +1,280 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrLocale locale is incorrect or not registered
+var ErrLocale = errors.New("incorrect locale")
+
+// Locale names and default patterns of a language.
+// Weekdays start from Sunday, as time.Weekday.
+type Locale struct {
+	Tag             string
+	Months          [12]string
+	ShortMonths     [12]string
+	Weekdays        [7]string
+	ShortWeekdays   [7]string
+	Quarters        [4]string
+	ShortQuarters   [4]string
+	AmPm            [2]string
+	DatePattern     string
+	LongDatePattern string
+	TimePattern     string
+	DatetimePattern string
+}
+
+// LocaleEn english
+var LocaleEn = Locale{
+	Tag:             "en",
+	Months:          [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonths:     [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Weekdays:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	Quarters:        [4]string{"1st quarter", "2nd quarter", "3rd quarter", "4th quarter"},
+	ShortQuarters:   [4]string{"Q1", "Q2", "Q3", "Q4"},
+	AmPm:            [2]string{"AM", "PM"},
+	DatePattern:     "MMM d, yyyy",
+	LongDatePattern: "EEEE, MMMM d, yyyy",
+	TimePattern:     "h:mm:ss a",
+	DatetimePattern: "MMM d, yyyy, h:mm:ss a",
+}
+
+// LocaleJa japanese
+var LocaleJa = Locale{
+	Tag:             "ja",
+	Months:          [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	ShortMonths:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	Weekdays:        [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	ShortWeekdays:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	Quarters:        [4]string{"第1四半期", "第2四半期", "第3四半期", "第4四半期"},
+	ShortQuarters:   [4]string{"Q1", "Q2", "Q3", "Q4"},
+	AmPm:            [2]string{"午前", "午後"},
+	DatePattern:     "yyyy年M月d日",
+	LongDatePattern: "yyyy年M月d日 EEEE",
+	TimePattern:     "H:mm:ss",
+	DatetimePattern: "yyyy年M月d日 H:mm:ss",
+}
+
+// registeredLocale a registered locale and its compiled patterns
+type registeredLocale struct {
+	locale   Locale
+	patterns *localePatterns
+}
+
+var (
+	localeMu sync.RWMutex
+	locales  = map[string]registeredLocale{
+		LocaleEn.Tag: {locale: LocaleEn, patterns: LocaleEn.mustCompilePatterns()},
+		LocaleJa.Tag: {locale: LocaleJa, patterns: LocaleJa.mustCompilePatterns()},
+	}
+)
+
+// RegisterLocale registers the locale and compiles its patterns. a registered locale of the same tag is replaced.
+func RegisterLocale(l Locale) error {
+	ps, err := l.compilePatterns()
+	if err != nil {
+		return err
+	}
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	locales[l.Tag] = registeredLocale{locale: l, patterns: ps}
+	return nil
+}
+
+// LookupLocale returns the registered locale of the tag
+func LookupLocale(tag string) (Locale, error) {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	r, ok := locales[tag]
+	if !ok {
+		return Locale{}, fmt.Errorf("%w: %q is not registered", ErrLocale, tag)
+	}
+	return r.locale, nil
+}
+
+// LocaleTags tags of the registered locales in order
+func LocaleTags() []string {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Valid valid locale. all names and patterns are required.
+func (l Locale) Valid() error {
+	if l.Tag == "" {
+		return fmt.Errorf("%w: empty tag", ErrLocale)
+	}
+	names := make([]string, 0)
+	names = append(names, l.Months[:]...)
+	names = append(names, l.ShortMonths[:]...)
+	names = append(names, l.Weekdays[:]...)
+	names = append(names, l.ShortWeekdays[:]...)
+	names = append(names, l.Quarters[:]...)
+	names = append(names, l.ShortQuarters[:]...)
+	names = append(names, l.AmPm[:]...)
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("%w: %q has empty name", ErrLocale, l.Tag)
+		}
+	}
+	for _, pattern := range []string{l.DatePattern, l.LongDatePattern, l.TimePattern, l.DatetimePattern} {
+		if _, err := CompilePattern(pattern); err != nil || pattern == "" {
+			return fmt.Errorf("%w: %q has incorrect pattern %q", ErrLocale, l.Tag, pattern)
+		}
+	}
+	return nil
+}
+
+// Pattern compiles the pattern with the locale
+func (l Locale) Pattern(pattern string) (*Pattern, error) {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return p.WithLocale(l), nil
+}
+
+// FormatDate formats the localDate by DatePattern. incorrect locale is an error.
+func (l Locale) FormatDate(d LocalDate) (string, error) {
+	ps, err := l.patterns()
+	if err != nil {
+		return "", err
+	}
+	return ps.date.FormatDate(d), nil
+}
+
+// FormatLongDate formats the localDate by LongDatePattern. incorrect locale is an error.
+func (l Locale) FormatLongDate(d LocalDate) (string, error) {
+	ps, err := l.patterns()
+	if err != nil {
+		return "", err
+	}
+	return ps.longDate.FormatDate(d), nil
+}
+
+// FormatTime formats the localTime by TimePattern. incorrect locale is an error.
+func (l Locale) FormatTime(t LocalTime) (string, error) {
+	ps, err := l.patterns()
+	if err != nil {
+		return "", err
+	}
+	return ps.time.FormatTime(t), nil
+}
+
+// FormatDatetime formats the localDatetime by DatetimePattern. incorrect locale is an error.
+func (l Locale) FormatDatetime(dt LocalDatetime) (string, error) {
+	ps, err := l.patterns()
+	if err != nil {
+		return "", err
+	}
+	return ps.datetime.FormatDatetime(dt), nil
+}
+
+// ParseDate parses the localDate by LongDatePattern or DatePattern. the error has the errors of both patterns.
+func (l Locale) ParseDate(s string) (LocalDate, error) {
+	ps, err := l.patterns()
+	if err != nil {
+		return LocalDate{}, err
+	}
+	d, longErr := ps.longDate.ParseDate(s)
+	if longErr == nil {
+		return d, nil
+	}
+	d, err = ps.date.ParseDate(s)
+	if err != nil {
+		return LocalDate{}, errors.Join(err, longErr)
+	}
+	return d, nil
+}
+
+// ParseDatetime parses the localDatetime by DatetimePattern
+func (l Locale) ParseDatetime(s string) (LocalDatetime, error) {
+	ps, err := l.patterns()
+	if err != nil {
+		return LocalDatetime{}, err
+	}
+	return ps.datetime.ParseDatetime(s)
+}
+
+// localePatterns compiled patterns of a locale
+type localePatterns struct {
+	date, longDate, time, datetime *Pattern
+}
+
+// patterns returns the compiled patterns of the locale.
+// registered locales are compiled once at registration, and other locales are compiled on each call.
+func (l Locale) patterns() (*localePatterns, error) {
+	localeMu.RLock()
+	r, ok := locales[l.Tag]
+	localeMu.RUnlock()
+	if ok && r.locale == l {
+		return r.patterns, nil
+	}
+	return l.compilePatterns()
+}
+
+func (l Locale) compilePatterns() (*localePatterns, error) {
+	if err := l.Valid(); err != nil {
+		return nil, err
+	}
+	return &localePatterns{
+		date:     MustCompilePattern(l.DatePattern).WithLocale(l),
+		longDate: MustCompilePattern(l.LongDatePattern).WithLocale(l),
+		time:     MustCompilePattern(l.TimePattern).WithLocale(l),
+		datetime: MustCompilePattern(l.DatetimePattern).WithLocale(l),
+	}, nil
+}
+
+func (l Locale) mustCompilePatterns() *localePatterns {
+	ps, err := l.compilePatterns()
+	if err != nil {
+		panic(err)
+	}
+	return ps
+}
+
+func (l Locale) monthName(m int, full bool) string {
+	if m < 1 || 12 < m {
+		return ""
+	}
+	if full {
+		return l.Months[m-1]
+	}
+	return l.ShortMonths[m-1]
+}
+
+func (l Locale) weekdayName(wd int, full bool) string {
+	if wd < 0 || 6 < wd {
+		return ""
+	}
+	if full {
+		return l.Weekdays[wd]
+	}
+	return l.ShortWeekdays[wd]
+}
+
+func (l Locale) quarterName(q int, full bool) string {
+	if q < 1 || 4 < q {
+		return ""
+	}
+	if full {
+		return l.Quarters[q-1]
+	}
+	return l.ShortQuarters[q-1]
+}
+
+func (l Locale) amPm(hour uint) string {
+	if hour < 12 {
+		return l.AmPm[0]
+	}
+	return l.AmPm[1]
+}
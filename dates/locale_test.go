@@ -0,0 +1,138 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+var localeFr = Locale{
+	Tag:             "fr",
+	Months:          [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ShortMonths:     [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	Weekdays:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	ShortWeekdays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	Quarters:        [4]string{"1er trimestre", "2e trimestre", "3e trimestre", "4e trimestre"},
+	ShortQuarters:   [4]string{"T1", "T2", "T3", "T4"},
+	AmPm:            [2]string{"AM", "PM"},
+	DatePattern:     "d MMMM yyyy",
+	LongDatePattern: "EEEE d MMMM yyyy",
+	TimePattern:     "HH:mm:ss",
+	DatetimePattern: "d MMMM yyyy HH:mm:ss",
+}
+
+func TestRegisterLocale(t *testing.T) {
+	Nil(t, RegisterLocale(localeFr))
+	fr, err := LookupLocale("fr")
+	Nil(t, err)
+	Equal(t, localeFr, fr)
+	Contains(t, LocaleTags(), "fr")
+	Contains(t, LocaleTags(), "ja")
+
+	_, err = LookupLocale("de")
+	True(t, errors.Is(err, ErrLocale))
+
+	invalid := localeFr
+	invalid.Tag = "fr-invalid"
+	invalid.Weekdays[3] = ""
+	True(t, errors.Is(RegisterLocale(invalid), ErrLocale), "名前が空")
+	invalid = localeFr
+	invalid.DatePattern = "d MMMM yyyy G"
+	True(t, errors.Is(RegisterLocale(invalid), ErrLocale), "patternが不正")
+	_, err = LookupLocale("fr-invalid")
+	True(t, errors.Is(err, ErrLocale), "不正なlocaleは登録されない")
+}
+
+func TestLocale_Format(t *testing.T) {
+	d := NewLocalDate(2024, 10, 16)
+	dt := NewLocalDatetime(2024, 10, 16, 13, 5, 0)
+	for _, table := range []struct {
+		title  string
+		format func() (string, error)
+		expect string
+	}{
+		{title: "英語", format: func() (string, error) { return LocaleEn.FormatDate(d) }, expect: "Oct 16, 2024"},
+		{title: "英語の曜日付き", format: func() (string, error) { return LocaleEn.FormatLongDate(d) }, expect: "Wednesday, October 16, 2024"},
+		{title: "英語の日時", format: func() (string, error) { return LocaleEn.FormatDatetime(dt) }, expect: "Oct 16, 2024, 1:05:00 PM"},
+		{title: "日本語", format: func() (string, error) { return LocaleJa.FormatDate(d) }, expect: "2024年10月16日"},
+		{title: "日本語の曜日付き", format: func() (string, error) { return LocaleJa.FormatLongDate(d) }, expect: "2024年10月16日 水曜日"},
+		{title: "日本語の時刻", format: func() (string, error) { return LocaleJa.FormatTime(dt.LocalTime) }, expect: "13:05:00"},
+		{title: "フランス語", format: func() (string, error) { return localeFr.FormatLongDate(d) }, expect: "mercredi 16 octobre 2024"},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := table.format()
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+
+	invalid := localeFr
+	invalid.DatePattern = "d MMMM yyyy G"
+	_, err := invalid.FormatDate(d)
+	True(t, errors.Is(err, ErrLocale), "不正なlocaleはerror")
+	_, err = Locale{}.FormatDatetime(dt)
+	True(t, errors.Is(err, ErrLocale), "zero valueはerror")
+	_, err = invalid.ParseDate("16 octobre 2024")
+	True(t, errors.Is(err, ErrLocale), "不正なlocaleは解析もerror")
+
+	first, err := LocaleEn.patterns()
+	Nil(t, err)
+	second, err := LocaleEn.patterns()
+	Nil(t, err)
+	Same(t, first, second, "登録済みのlocaleのpatternは一度だけcompileする")
+	adhoc := LocaleEn
+	adhoc.DatePattern = "yyyy/MM/dd"
+	first, err = adhoc.patterns()
+	Nil(t, err)
+	second, err = adhoc.patterns()
+	Nil(t, err)
+	NotSame(t, first, second, "未登録のlocaleはcacheしない")
+	formatted, err := adhoc.FormatDate(d)
+	Nil(t, err)
+	Equal(t, "2024/10/16", formatted, "登録済みと同じtagでも内容が異なるlocaleは自身のpatternを使う")
+
+	p, err := LocaleJa.Pattern("yyyy年M月d日(E) a h時 QQQQ")
+	Nil(t, err)
+	Equal(t, "2024年10月16日(水) 午後 1時 第4四半期", p.FormatDatetime(dt))
+	Equal(t, "Wed", MustCompilePattern("EEE").FormatDate(d), "localeの指定がない場合は英語")
+	Equal(t, "ja", p.Locale().Tag)
+}
+
+func TestLocale_Parse(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		locale Locale
+		input  string
+		expect LocalDate
+		err    bool
+	}{
+		{title: "日本語の曜日付き", locale: LocaleJa, input: "2024年10月16日 水曜日", expect: NewLocalDate(2024, 10, 16)},
+		{title: "日本語", locale: LocaleJa, input: "2024年10月16日", expect: NewLocalDate(2024, 10, 16)},
+		{title: "日本語の曜日が一致しない", locale: LocaleJa, input: "2024年10月16日 木曜日", err: true},
+		{title: "フランス語", locale: localeFr, input: "16 octobre 2024", expect: NewLocalDate(2024, 10, 16)},
+		{title: "フランス語の曜日付き", locale: localeFr, input: "mercredi 16 octobre 2024", expect: NewLocalDate(2024, 10, 16)},
+		{title: "英語", locale: LocaleEn, input: "Oct 16, 2024", expect: NewLocalDate(2024, 10, 16)},
+		{title: "他のlocaleの名前", locale: LocaleEn, input: "16 octobre 2024", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := table.locale.ParseDate(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrParse))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	_, err := LocaleEn.ParseDate("16 octobre 2024")
+	Contains(t, err.Error(), `"MMM d, yyyy"`)
+	Contains(t, err.Error(), `"EEEE, MMMM d, yyyy"`, "LongDatePatternのerrorも含む")
+	{
+		p, err := LocaleJa.Pattern("yyyy/MM/dd a h:mm")
+		Nil(t, err)
+		dt, err := p.ParseDatetime("2024/10/16 午後 1:05")
+		Nil(t, err)
+		Equal(t, NewLocalDatetime(2024, 10, 16, 13, 5, 0), dt)
+	}
+}
@@ -0,0 +1,195 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// leapYearForMonthDay 02-29を検証するための閏年
+const leapYearForMonthDay uint = 2000
+
+// LeapDayPolicy decides which date 02-29 falls on in a non-leap year.
+type LeapDayPolicy int
+
+// leapDayPolicy enums
+const (
+	// LeapDayToFeb28 02-29 falls on 02-28 in a non-leap year.
+	LeapDayToFeb28 LeapDayPolicy = iota
+	// LeapDayToMar1 02-29 falls on 03-01 in a non-leap year.
+	LeapDayToMar1
+)
+
+// MonthDay represents a month-day without a year, such as birthdays and anniversaries.
+type MonthDay struct {
+	Month uint
+	Day   uint
+}
+
+// Valid valid monthDay. 02-29 is valid.
+func (md MonthDay) Valid() (MonthDay, error) {
+	if md.Month < MinMonthOfYear || MaxMonthOfYear < md.Month {
+		return md, newRangeError("monthDay", "month", md.Month, MinMonthOfYear, MaxMonthOfYear)
+	}
+	if md.Day < MinDayOfMonth || daysInMonth(leapYearForMonthDay, md.Month) < md.Day {
+		return md, newRangeError("monthDay", "day", md.Day, MinDayOfMonth, daysInMonth(leapYearForMonthDay, md.Month))
+	}
+	return md, nil
+}
+
+// Value for go-sql-driver. format: --MM-dd
+func (md MonthDay) Value() (driver.Value, error) {
+	return md.String(), nil
+}
+
+// Scan for go-sql-driver. --MM-dd and MM-dd are accepted.
+func (md *MonthDay) Scan(value interface{}) error {
+	if md == nil || value == nil {
+		return &ScanError{Type: "monthDay", Value: value, Err: errNilValue}
+	}
+	var monthDay MonthDay
+	var err error
+	switch v := value.(type) {
+	case string:
+		monthDay, err = scanCanonicalMonthDay(v)
+	case []byte:
+		monthDay, err = scanCanonicalMonthDay(v)
+	default:
+		sv, ce := driver.String.ConvertValue(value)
+		str, ok := sv.(string)
+		if ce != nil || !ok {
+			return &ScanError{Type: "monthDay", Value: value, Err: errUnsupportedType}
+		}
+		monthDay, err = scanCanonicalMonthDay(str)
+	}
+	if err == nil {
+		monthDay, err = monthDay.Valid()
+	}
+	if err != nil {
+		return newScanError("monthDay", value, err)
+	}
+	*md = monthDay
+	return nil
+}
+
+// String to string. ISO 8601 format: --MM-dd
+func (md MonthDay) String() string {
+	_, month, day := LocalDate{Month: md.Month, Day: md.Day}.SplitString()
+	return "--" + month + "-" + day
+}
+
+// IsZero monthDay is zero?
+func (md MonthDay) IsZero() bool {
+	return md.Month == 0 && md.Day == 0
+}
+
+// IsValidYear reports whether the monthDay exists in the year. 02-29 exists only in leap years.
+func (md MonthDay) IsValidYear(year int) bool {
+	return year > 0 && md.Day <= daysInMonth(uint(year), md.Month)
+}
+
+// AtYear returns the localDate of the monthDay in the year.
+// 02-29 in a non-leap year is resolved by policy.
+func (md MonthDay) AtYear(year int, policy LeapDayPolicy) LocalDate {
+	if md.IsValidYear(year) {
+		return LocalDate{Year: uint(year), Month: md.Month, Day: md.Day}
+	}
+	if policy == LeapDayToMar1 {
+		return NewLocalDate(year, 3, 1)
+	}
+	return NewLocalDate(year, 2, 28)
+}
+
+// NextOccurrence returns the first occurrence of the monthDay on or after from.
+func (md MonthDay) NextOccurrence(from LocalDate, policy LeapDayPolicy) LocalDate {
+	occurrence := md.AtYear(int(from.Year), policy)
+	if occurrence.Before(from) {
+		return md.AtYear(int(from.Year)+1, policy)
+	}
+	return occurrence
+}
+
+// Before reports whether the monthDay is before target in a year.
+func (md MonthDay) Before(target MonthDay) bool {
+	return md.Month < target.Month || (md.Month == target.Month && md.Day < target.Day)
+}
+
+// After reports whether the monthDay is after target in a year.
+func (md MonthDay) After(target MonthDay) bool {
+	return target.Before(md)
+}
+
+// Equal monthDay Equal?
+func (md MonthDay) Equal(target MonthDay) bool {
+	return md.Month == target.Month && md.Day == target.Day
+}
+
+// MarshalJSON for json return format: --MM-dd
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	if md.IsZero() {
+		return MarshalJSON(nil)
+	}
+	return MarshalJSON(md.String())
+}
+
+// UnmarshalJSON for json default format --MM-dd
+func (md *MonthDay) UnmarshalJSON(data []byte) error {
+	if md == nil || len(data) == 0 {
+		return fmt.Errorf("%w: monthDay, receiver is nil or data len is 0", ErrUnmarshalJSON)
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal monthDay, err: %w", ErrUnmarshalJSON, err)
+	}
+	monthDay, err := ParseMonthDay(MonthDayISO, str)
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse monthDay, err: %w", ErrUnmarshalJSON, err)
+	}
+	*md = monthDay
+	return nil
+}
+
+// UnmarshalFlag for flag default format --MM-dd
+func (md *MonthDay) UnmarshalFlag(s string) error {
+	if md == nil || len(s) == 0 {
+		return fmt.Errorf("%w: monthDay. receiver is nil or data len is 0", ErrUnmarshalFlag)
+	}
+	monthDay, err := ParseMonthDay(MonthDayISO, s)
+	if err != nil {
+		return fmt.Errorf("%w: monthDay, err: %w", ErrUnmarshalFlag, err)
+	}
+	*md = monthDay
+	return nil
+}
+
+// NewMonthDay new monthDay
+// Unlike NewLocalDate, no calendar calculation is performed because there is no year. An error is returned instead.
+func NewMonthDay(month, day int) (MonthDay, error) {
+	if month < 0 || day < 0 {
+		return MonthDay{}, fmt.Errorf("%w: monthDay month: %d, day: %d", ErrOutOfRangeDate, month, day)
+	}
+	return MonthDay{Month: uint(month), Day: uint(day)}.Valid()
+}
+
+// MonthDayFromDate localDate to monthDay
+func MonthDayFromDate(d LocalDate) MonthDay {
+	return MonthDay{Month: d.Month, Day: d.Day}
+}
+
+// ParseMonthDay parse monthDay by string
+func ParseMonthDay(f Format, t string) (MonthDay, error) {
+	loc := UTC.Location() //monthDayのため、このtimezoneは使用しない
+
+	// 年を含まないformatの場合, 閏年である0年として解析されるため02-29も解析できる
+	tm, err := time.ParseInLocation(f.String(), t, loc)
+	if err != nil {
+		return MonthDay{}, newParseError(f, t, err)
+	}
+	return MonthDay{Month: uint(tm.Month()), Day: uint(tm.Day())}, nil
+}
+
+// MonthDay localDate to monthDay
+func (d LocalDate) MonthDay() MonthDay {
+	return MonthDayFromDate(d)
+}
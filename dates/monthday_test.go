@@ -0,0 +1,130 @@
+package dates
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+type MonthDayStruct struct {
+	MonthDay MonthDay `json:"month_day"`
+}
+
+func TestNewMonthDay(t *testing.T) {
+	for _, table := range []struct {
+		title         string
+		month         int
+		day           int
+		expect        MonthDay
+		errorOccurred bool
+	}{
+		{title: "通常の日付", month: 10, day: 16, expect: MonthDay{Month: 10, Day: 16}},
+		{title: "02-29は有効", month: 2, day: 29, expect: MonthDay{Month: 2, Day: 29}},
+		{title: "02-30は無効", month: 2, day: 30, errorOccurred: true},
+		{title: "04-31は無効", month: 4, day: 31, errorOccurred: true},
+		{title: "13月は無効", month: 13, day: 1, errorOccurred: true},
+		{title: "負数は無効", month: -1, day: 1, errorOccurred: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := NewMonthDay(table.month, table.day)
+			if table.errorOccurred {
+				True(t, errors.Is(err, ErrOutOfRangeDate))
+			} else {
+				Nil(t, err)
+				Equal(t, table.expect, actual)
+			}
+		})
+	}
+}
+
+func TestMonthDay_AtYear(t *testing.T) {
+	leapDay := MonthDay{Month: 2, Day: 29}
+	Equal(t, NewLocalDate(2024, 2, 29), leapDay.AtYear(2024, LeapDayToFeb28), "閏年はそのまま")
+	Equal(t, NewLocalDate(2023, 2, 28), leapDay.AtYear(2023, LeapDayToFeb28))
+	Equal(t, NewLocalDate(2023, 3, 1), leapDay.AtYear(2023, LeapDayToMar1))
+	Equal(t, NewLocalDate(2023, 10, 16), MonthDay{Month: 10, Day: 16}.AtYear(2023, LeapDayToMar1))
+	False(t, leapDay.IsValidYear(1900))
+	True(t, leapDay.IsValidYear(2000))
+}
+
+func TestMonthDay_NextOccurrence(t *testing.T) {
+	birthday := MonthDay{Month: 10, Day: 16}
+	Equal(t, NewLocalDate(2024, 10, 16), birthday.NextOccurrence(NewLocalDate(2024, 10, 1), LeapDayToFeb28))
+	Equal(t, NewLocalDate(2024, 10, 16), birthday.NextOccurrence(NewLocalDate(2024, 10, 16), LeapDayToFeb28), "当日を含む")
+	Equal(t, NewLocalDate(2025, 10, 16), birthday.NextOccurrence(NewLocalDate(2024, 10, 17), LeapDayToFeb28))
+
+	leapDay := MonthDay{Month: 2, Day: 29}
+	Equal(t, NewLocalDate(2024, 2, 29), leapDay.NextOccurrence(NewLocalDate(2023, 3, 1), LeapDayToFeb28))
+	Equal(t, NewLocalDate(2025, 3, 1), leapDay.NextOccurrence(NewLocalDate(2024, 3, 1), LeapDayToMar1))
+}
+
+func TestMonthDay_Compare(t *testing.T) {
+	md1, md2 := MonthDay{Month: 1, Day: 31}, MonthDay{Month: 2, Day: 1}
+	True(t, md1.Before(md2))
+	True(t, md2.After(md1))
+	False(t, md1.After(md1))
+	True(t, md1.Equal(MonthDay{Month: 1, Day: 31}))
+}
+
+func TestMonthDay_Scan(t *testing.T) {
+	for _, table := range []struct {
+		title         string
+		value         interface{}
+		expect        MonthDay
+		errorOccurred bool
+	}{
+		{title: "--MM-dd", value: "--02-29", expect: MonthDay{Month: 2, Day: 29}},
+		{title: "MM-dd", value: "10-16", expect: MonthDay{Month: 10, Day: 16}},
+		{title: "存在しない日付", value: "--02-30", errorOccurred: true},
+		{title: "yyyy-MM-dd", value: "2020-10-16", errorOccurred: true},
+		{title: "nil", value: nil, errorOccurred: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			md := new(MonthDay)
+			err := md.Scan(table.value)
+			if table.errorOccurred {
+				True(t, errors.Is(err, ErrScan))
+			} else {
+				Nil(t, err)
+			}
+			Equal(t, table.expect, *md)
+		})
+	}
+}
+
+func TestMonthDay_Value(t *testing.T) {
+	value, err := MonthDay{Month: 2, Day: 9}.Value()
+	Nil(t, err)
+	Equal(t, "--02-09", value)
+}
+
+func TestMonthDay_JSON(t *testing.T) {
+	{
+		jsonBytes, err := json.Marshal(MonthDayStruct{MonthDay: MonthDay{Month: 12, Day: 24}})
+		Nil(t, err)
+		Equal(t, `{"month_day":"--12-24"}`, string(jsonBytes))
+	}
+	{
+		jsonBytes, err := json.Marshal(MonthDayStruct{})
+		Nil(t, err)
+		Equal(t, `{"month_day":null}`, string(jsonBytes))
+	}
+	{
+		var jsonStruct MonthDayStruct
+		err := json.Unmarshal([]byte(`{"month_day":"--02-29"}`), &jsonStruct)
+		Nil(t, err)
+		Equal(t, MonthDay{Month: 2, Day: 29}, jsonStruct.MonthDay)
+	}
+	{
+		var jsonStruct MonthDayStruct
+		err := json.Unmarshal([]byte(`{"month_day":"12-24"}`), &jsonStruct)
+		True(t, errors.Is(err, ErrUnmarshalJSON), "invalid format")
+	}
+	{
+		md := new(MonthDay)
+		Nil(t, md.UnmarshalFlag("--07-07"))
+		Equal(t, MonthDay{Month: 7, Day: 7}, *md)
+	}
+}
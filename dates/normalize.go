@@ -0,0 +1,152 @@
+package dates
+
+import "database/sql/driver"
+
+// LenientLocalDate localDate which normalizes impossible dates on Scan, as NewLocalDate.
+// LocalDate.Scan rejects them.
+//
+//	2023-02-31 ---> 2023-03-03
+type LenientLocalDate struct {
+	LocalDate LocalDate
+}
+
+// LenientLocalDatetime localDatetime which normalizes impossible datetimes on Scan, as NewLocalDatetime.
+//
+//	2023-02-28 24:00:00 ---> 2023-03-01 00:00:00
+type LenientLocalDatetime struct {
+	LocalDatetime LocalDatetime
+}
+
+// LenientYearMonth yearMonth which normalizes impossible months on Scan, as NewYearMonth.
+//
+//	2023-13 ---> 2024-01
+type LenientYearMonth struct {
+	YearMonth YearMonth
+}
+
+// Normalize normalizes by calendar calculation, as NewLocalDate. returns empty when the result is in BC.
+//
+//	(2023, 2, 31) ---> 2023-03-03
+func (d LocalDate) Normalize() LocalDate {
+	return NewLocalDate(int(d.Year), int(d.Month), int(d.Day))
+}
+
+// Normalize normalizes overflowing minutes and seconds. the overflowing days are dropped.
+//
+//	(24, 61, 0) ---> 01:01:00
+func (t LocalTime) Normalize() LocalTime {
+	seconds := (t.Hour*SecondsOfHour + t.Minute*SecondsOfMinute + t.Second) % SecondsOfDay
+	return LocalTime{Hour: seconds / SecondsOfHour, Minute: seconds % SecondsOfHour / SecondsOfMinute, Second: seconds % SecondsOfMinute}
+}
+
+// Normalize normalizes by calendar calculation, as NewLocalDatetime. returns empty when the result is in BC.
+//
+//	2023-02-28 24:00:00 ---> 2023-03-01 00:00:00
+func (dt LocalDatetime) Normalize() LocalDatetime {
+	d, t := dt.LocalDate, dt.LocalTime
+	return NewLocalDatetime(d.Year, d.Month, d.Day, int(t.Hour), int(t.Minute), int(t.Second))
+}
+
+// Normalize normalizes by calendar calculation, as NewYearMonth. returns empty when the result is in BC.
+//
+//	(2023, 13) ---> 2024-01
+func (ym YearMonth) Normalize() YearMonth {
+	return NewYearMonth(int(ym.Year), int(ym.Month))
+}
+
+// Value for go-sql-driver
+func (d LenientLocalDate) Value() (driver.Value, error) {
+	return d.LocalDate.Value()
+}
+
+// Scan for go-sql-driver. the values are converted as LocalDate.Scan.
+func (d *LenientLocalDate) Scan(value interface{}) error {
+	if d == nil || value == nil {
+		return &ScanError{Type: "lenientLocalDate", Value: value, Err: errNilValue}
+	}
+	date, err := scanLocalDate("lenientLocalDate", value, normalizedLocalDate)
+	if err != nil {
+		return err
+	}
+	d.LocalDate = date
+	return nil
+}
+
+// Value for go-sql-driver
+func (dt LenientLocalDatetime) Value() (driver.Value, error) {
+	return dt.LocalDatetime.Value()
+}
+
+// Scan for go-sql-driver. the values are converted as LocalDatetime.Scan.
+func (dt *LenientLocalDatetime) Scan(value interface{}) error {
+	if dt == nil || value == nil {
+		return &ScanError{Type: "lenientLocalDatetime", Value: value, Err: errNilValue}
+	}
+	datetime, err := scanLocalDatetime("lenientLocalDatetime", value, normalizedLocalDatetime)
+	if err != nil {
+		return err
+	}
+	dt.LocalDatetime = datetime
+	return nil
+}
+
+// Value for go-sql-driver
+func (ym LenientYearMonth) Value() (driver.Value, error) {
+	return ym.YearMonth.Value()
+}
+
+// Scan for go-sql-driver. the values are converted as YearMonth.Scan.
+func (ym *LenientYearMonth) Scan(value interface{}) error {
+	if ym == nil || value == nil {
+		return &ScanError{Type: "lenientYearMonth", Value: value, Err: errNilValue}
+	}
+	yearMonth, err := scanYearMonth("lenientYearMonth", value, normalizedYearMonth)
+	if err != nil {
+		return err
+	}
+	ym.YearMonth = yearMonth
+	return nil
+}
+
+// strictLocalDate rejects impossible dates. the zero date 0000-00-00 of MySQL is allowed.
+func strictLocalDate(d LocalDate) (LocalDate, error) {
+	if d.IsZero() {
+		return d, nil
+	}
+	return d.Valid()
+}
+
+func strictLocalDatetime(dt LocalDatetime) (LocalDatetime, error) {
+	if dt.IsZero() {
+		return dt, nil
+	}
+	return dt.Valid()
+}
+
+func strictYearMonth(ym YearMonth) (YearMonth, error) {
+	if ym.IsZero() {
+		return ym, nil
+	}
+	return ym.Valid()
+}
+
+func normalizedLocalDate(d LocalDate) (LocalDate, error) {
+	if d.IsZero() {
+		return d, nil
+	}
+	return d.Normalize(), nil
+}
+
+func normalizedLocalDatetime(dt LocalDatetime) (LocalDatetime, error) {
+	if dt.IsZero() {
+		return dt, nil
+	}
+	return dt.Normalize(), nil
+}
+
+func normalizedYearMonth(ym YearMonth) (YearMonth, error) {
+	if ym.IsZero() {
+		return ym, nil
+	}
+	return ym.Normalize(), nil
+}
@@ -0,0 +1,108 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDate_Valid(t *testing.T) {
+	for _, table := range []struct {
+		title string
+		input LocalDate
+		err   bool
+	}{
+		{title: "閏年の2/29", input: LocalDate{Year: 2024, Month: 2, Day: 29}},
+		{title: "平年の2/29", input: LocalDate{Year: 2023, Month: 2, Day: 29}, err: true},
+		{title: "100で割り切れる年は平年", input: LocalDate{Year: 1900, Month: 2, Day: 29}, err: true},
+		{title: "400で割り切れる年は閏年", input: LocalDate{Year: 2000, Month: 2, Day: 29}},
+		{title: "2/31", input: LocalDate{Year: 2023, Month: 2, Day: 31}, err: true},
+		{title: "4/31", input: LocalDate{Year: 2023, Month: 4, Day: 31}, err: true},
+		{title: "12/31", input: LocalDate{Year: 2023, Month: 12, Day: 31}},
+		{title: "0日", input: LocalDate{Year: 2023, Month: 1, Day: 0}, err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			_, err := table.input.Valid()
+			if table.err {
+				True(t, errors.Is(err, ErrOutOfRangeDate))
+				return
+			}
+			Nil(t, err)
+		})
+	}
+	{
+		t0, err := NewLocalTime(0, 0, 0)
+		Nil(t, err, "0時は有効")
+		Equal(t, LocalTime{}, t0)
+		_, err = NewLocalTime(24, 0, 0)
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+		_, err = LocalDatetime{LocalDate: LocalDate{Year: 2023, Month: 2, Day: 28}, LocalTime: LocalTime{Minute: 60}}.Valid()
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	Equal(t, NewLocalDate(2023, 3, 3), LocalDate{Year: 2023, Month: 2, Day: 31}.Normalize())
+	Equal(t, NewLocalDate(2024, 2, 29), LocalDate{Year: 2024, Month: 2, Day: 29}.Normalize(), "有効な日付は変わらない")
+	Equal(t, LocalTime{Hour: 1, Minute: 1}, LocalTime{Hour: 24, Minute: 61}.Normalize())
+	Equal(t, NewLocalDatetime(2023, 3, 1, 0, 0, 0), LocalDatetime{
+		LocalDate: LocalDate{Year: 2023, Month: 2, Day: 28},
+		LocalTime: LocalTime{Hour: 24},
+	}.Normalize())
+	Equal(t, YearMonth{Year: 2024, Month: 1}, YearMonth{Year: 2023, Month: 13}.Normalize())
+}
+
+func TestScan_Strict(t *testing.T) {
+	var d LocalDate
+	err := d.Scan("2023-02-31")
+	True(t, errors.Is(err, ErrScan))
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+	var dt LocalDatetime
+	True(t, errors.Is(dt.Scan("2023-02-28 24:00:00"), ErrOutOfRangeDate))
+	var ym YearMonth
+	True(t, errors.Is(ym.Scan("2023-13"), ErrOutOfRangeDate))
+	Nil(t, d.Scan("2024-02-29"))
+	Equal(t, NewLocalDate(2024, 2, 29), d)
+}
+
+func TestScan_ZeroDate(t *testing.T) {
+	d := NewLocalDate(2024, 10, 16)
+	Nil(t, d.Scan("0000-00-00"), "MySQLのzero dateはzero valueとして扱う")
+	True(t, d.IsZero())
+	dt := NewLocalDatetime(2024, 10, 16, 1, 2, 3)
+	Nil(t, dt.Scan([]byte("0000-00-00 00:00:00")))
+	True(t, dt.IsZero())
+	ym := YearMonth{Year: 2024, Month: 10}
+	Nil(t, ym.Scan("0000-00"))
+	True(t, ym.IsZero())
+	True(t, errors.Is(d.Scan("2024-00-00"), ErrOutOfRangeDate), "zero dateでない不正な日付はerror")
+
+	lenient := LenientLocalDate{LocalDate: NewLocalDate(2024, 10, 16)}
+	Nil(t, lenient.Scan("0000-00-00"))
+	True(t, lenient.LocalDate.IsZero(), "lenientでもzero dateはzero value")
+}
+
+func TestLenient_Scan(t *testing.T) {
+	var d LenientLocalDate
+	Nil(t, d.Scan("2023-02-31"))
+	Equal(t, NewLocalDate(2023, 3, 3), d.LocalDate)
+	Nil(t, d.Scan([]byte("2023-02-29")))
+	Equal(t, NewLocalDate(2023, 3, 1), d.LocalDate, "[]byteも正規化")
+	v, err := d.Value()
+	Nil(t, err)
+	Equal(t, "2023-03-01", v)
+	True(t, errors.Is(d.Scan(nil), ErrScan))
+
+	var dt LenientLocalDatetime
+	Nil(t, dt.Scan("2023-02-28 24:00:00"))
+	Equal(t, NewLocalDatetime(2023, 3, 1, 0, 0, 0), dt.LocalDatetime)
+
+	var ym LenientYearMonth
+	Nil(t, ym.Scan("2023-13"))
+	Equal(t, YearMonth{Year: 2024, Month: 1}, ym.YearMonth)
+
+	var strict LocalDate
+	True(t, errors.Is(strict.Scan("2023-02-31"), ErrOutOfRangeDate), "lenientの利用は他のScanに影響しない")
+	True(t, errors.Is(strict.UnmarshalJSON([]byte(`"2023-02-31"`)), ErrUnmarshalJSON), "UnmarshalJSONは常にstrict")
+}
@@ -0,0 +1,155 @@
+package dates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseMode mode of Parser
+type ParseMode int
+
+// parse mode enums
+const (
+	// ParseStrict the input must match a format exactly
+	ParseStrict ParseMode = iota
+	// ParseLenient surrounding whitespace, full-width characters and
+	// single-digit months, days, minutes and seconds in formats with separators are accepted.
+	ParseLenient
+)
+
+// DetectionFormats formats tried by Parser when no format is given, in order.
+// DateAbbreviated is not included, since it cannot be distinguished from Month. (200112)
+var DetectionFormats = []Format{
+	DateTimeHyphen,
+	DateTimeSlash,
+	DateTime,
+	DateHyphen,
+	DateSlash,
+	Date,
+	DateHourHyphen,
+	DateHourSlash,
+	DateHour,
+	RFC3339,
+	RFC3339Nano,
+	DateRFC3339,
+	RFC1123Z,
+	RFC1123,
+	RFC850,
+	RFC822Z,
+	RFC822,
+	RubyDate,
+	UnixDate,
+	ANSIC,
+	MonthHyphen,
+	Month,
+}
+
+// lenientReplacer relaxes zero padded fields after separators
+var lenientReplacer = strings.NewReplacer("-01", "-1", "-02", "-2", "/01", "/1", "/02", "/2", ":04", ":4", ":05", ":5")
+
+// Parser parses by formats in order. Parser is safe for concurrent use.
+type Parser struct {
+	formats []Format
+	mode    ParseMode
+}
+
+// ParseAttempt a format tried by Parser and its error
+type ParseAttempt struct {
+	Format Format
+	Err    error
+}
+
+// ParseAttemptsError no format matched. errors.Is(err, ErrParse) is true.
+type ParseAttemptsError struct {
+	Input    string
+	Attempts []ParseAttempt
+}
+
+// NewParser new parser. when formats are empty, DetectionFormats are used.
+func NewParser(mode ParseMode, formats ...Format) *Parser {
+	if len(formats) == 0 {
+		formats = DetectionFormats
+	}
+	fs := make([]Format, len(formats))
+	copy(fs, formats)
+	return &Parser{formats: fs, mode: mode}
+}
+
+// Formats formats of the parser in order
+func (p *Parser) Formats() []Format {
+	fs := make([]Format, len(p.formats))
+	copy(fs, p.formats)
+	return fs
+}
+
+// ParseLocalDate parses localDate, and returns the matched format.
+// Time of datetime formats is ignored.
+func (p *Parser) ParseLocalDate(t string) (LocalDate, Format, error) {
+	tm, f, err := p.parse(t)
+	if err != nil {
+		return LocalDate{}, "", err
+	}
+	return LocalDateFromTime(tm), f, nil
+}
+
+// ParseLocalDatetime parses localDatetime, and returns the matched format.
+// Time of date formats is midnight.
+func (p *Parser) ParseLocalDatetime(t string) (LocalDatetime, Format, error) {
+	tm, f, err := p.parse(t)
+	if err != nil {
+		return LocalDatetime{}, "", err
+	}
+	return LocalDatetimeFromTime(tm), f, nil
+}
+
+func (p *Parser) parse(t string) (time.Time, Format, error) {
+	input := t
+	if p.mode == ParseLenient {
+		input = strings.TrimSpace(normalizeFullWidth(t))
+	}
+	attempts := make([]ParseAttempt, 0, len(p.formats))
+	for _, f := range p.formats {
+		layout := f.String()
+		if p.mode == ParseLenient {
+			layout = lenientReplacer.Replace(layout)
+		}
+		tm, err := time.ParseInLocation(layout, input, UTC.Location())
+		if err == nil {
+			return tm, f, nil
+		}
+		attempts = append(attempts, ParseAttempt{Format: f, Err: err})
+	}
+	return time.Time{}, "", &ParseAttemptsError{Input: t, Attempts: attempts}
+}
+
+// Error error message listing all attempts
+func (e *ParseAttemptsError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v: %q matched no format", ErrParse, e.Input)
+	for _, a := range e.Attempts {
+		fmt.Fprintf(&b, "\n\t%s: %v", a.Format, a.Err)
+	}
+	return b.String()
+}
+
+// Unwrap for errors.Is(err, ErrParse)
+func (e *ParseAttemptsError) Unwrap() error {
+	return ErrParse
+}
+
+// normalizeFullWidth 全角英数字と全角記号を半角に, 全角スペースを半角スペースに変換する
+func normalizeFullWidth(t string) string {
+	var b strings.Builder
+	b.Grow(len(t))
+	for _, r := range t {
+		switch {
+		case r == '　':
+			r = ' '
+		case '！' <= r && r <= '～':
+			r = r - '！' + '!'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
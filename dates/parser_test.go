@@ -0,0 +1,70 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParser_ParseLocalDate(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		parser *Parser
+		input  string
+		expect LocalDate
+		format Format
+		err    bool
+	}{
+		{title: "ハイフン", parser: NewParser(ParseStrict), input: "2024-10-16", expect: NewLocalDate(2024, 10, 16), format: DateHyphen},
+		{title: "スラッシュ", parser: NewParser(ParseStrict), input: "2024/10/16", expect: NewLocalDate(2024, 10, 16), format: DateSlash},
+		{title: "区切りなし", parser: NewParser(ParseStrict), input: "20241016", expect: NewLocalDate(2024, 10, 16), format: Date},
+		{title: "日時の時刻は無視", parser: NewParser(ParseStrict), input: "2024-10-16 00:00:00", expect: NewLocalDate(2024, 10, 16), format: DateTimeHyphen},
+		{title: "RFC3339", parser: NewParser(ParseStrict), input: "2024-10-16T23:00:00+09:00", expect: NewLocalDate(2024, 10, 16), format: RFC3339},
+		{title: "年月", parser: NewParser(ParseStrict), input: "2024-10", expect: NewLocalDate(2024, 10, 1), format: MonthHyphen},
+		{title: "strictは1桁の月を許容しない", parser: NewParser(ParseStrict), input: "2024-1-6", err: true},
+		{title: "strictは空白を許容しない", parser: NewParser(ParseStrict), input: " 2024-10-16 ", err: true},
+		{title: "lenientは1桁の月を許容", parser: NewParser(ParseLenient), input: "2024/1/6", expect: NewLocalDate(2024, 1, 6), format: DateSlash},
+		{title: "lenientは空白を許容", parser: NewParser(ParseLenient), input: "\t2024-10-16　", expect: NewLocalDate(2024, 10, 16), format: DateHyphen},
+		{title: "lenientは全角数字を許容", parser: NewParser(ParseLenient), input: "２０２４／１０／１６", expect: NewLocalDate(2024, 10, 16), format: DateSlash},
+		{title: "lenientでも存在しない日付は不可", parser: NewParser(ParseLenient), input: "2023-02-29", err: true},
+		{title: "指定したformatのみ", parser: NewParser(ParseStrict, DateSlash, Date), input: "2024-10-16", err: true},
+		{title: "指定したformatの順に試す", parser: NewParser(ParseStrict, DateSlash, Date), input: "20241016", expect: NewLocalDate(2024, 10, 16), format: Date},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, f, err := table.parser.ParseLocalDate(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrParse))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+			Equal(t, table.format, f)
+		})
+	}
+}
+
+func TestParser_ParseLocalDatetime(t *testing.T) {
+	p := NewParser(ParseLenient, DateTimeHyphen, DateHyphen)
+	dt, f, err := p.ParseLocalDatetime("2024-10-16 9:05:03")
+	Nil(t, err)
+	Equal(t, NewLocalDatetime(2024, 10, 16, 9, 5, 3), dt)
+	Equal(t, DateTimeHyphen, f)
+
+	dt, f, err = p.ParseLocalDatetime("2024-10-16")
+	Nil(t, err)
+	Equal(t, NewLocalDatetime(2024, 10, 16, 0, 0, 0), dt, "日付のみの場合0時")
+	Equal(t, DateHyphen, f)
+
+	_, _, err = p.ParseLocalDatetime("16/10/2024")
+	var attemptsErr *ParseAttemptsError
+	True(t, errors.As(err, &attemptsErr))
+	Equal(t, "16/10/2024", attemptsErr.Input)
+	Len(t, attemptsErr.Attempts, 2)
+	Equal(t, DateTimeHyphen, attemptsErr.Attempts[0].Format)
+	Equal(t, DateHyphen, attemptsErr.Attempts[1].Format)
+	Contains(t, err.Error(), DateTimeHyphen.String())
+	Contains(t, err.Error(), DateHyphen.String())
+
+	Equal(t, DetectionFormats, NewParser(ParseStrict).Formats())
+}
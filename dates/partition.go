@@ -0,0 +1,160 @@
+package dates
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PartitionGranularity granularity of partitions
+type PartitionGranularity int
+
+// partition granularity enums
+const (
+	// PartitionDaily one partition per day. named by Date format (events_20241016)
+	PartitionDaily PartitionGranularity = iota
+	// PartitionMonthly one partition per month. named by Month format (logs_202410)
+	PartitionMonthly
+)
+
+var _PartitionGranularityFormatMap = map[PartitionGranularity]Format{
+	PartitionDaily:   Date,
+	PartitionMonthly: Month,
+}
+
+// MaxPartitionYear the last year which partition names can be parsed back. (4 digits year)
+const MaxPartitionYear uint = 9999
+
+// Format format of the partition name suffix
+func (g PartitionGranularity) Format() Format {
+	return _PartitionGranularityFormatMap[g]
+}
+
+// Valid validate partitionGranularity
+func (g PartitionGranularity) Valid() (PartitionGranularity, error) {
+	if _, ok := _PartitionGranularityFormatMap[g]; !ok {
+		return g, fmt.Errorf("%w: partition granularity: %d", ErrOutOfRangeDate, g)
+	}
+	return g, nil
+}
+
+// Partition a partition and its bounds. the bounds are half-open [From, To).
+type Partition struct {
+	Name string
+	From LocalDate
+	To   LocalDate
+}
+
+// Period period of the partition. the end is inclusive, as other LocalDatePeriods. zero To is unbounded.
+func (p Partition) Period() LocalDatePeriod {
+	if p.To.IsZero() {
+		return LocalDatePeriod{Start: p.From, End: LocalDate{Year: MaxYear, Month: MaxMonthOfYear, Day: 31}}
+	}
+	return LocalDatePeriod{Start: p.From, End: addDays(p.To, -1)}
+}
+
+// Contains reports whether the localDate belongs to the partition. zero To is unbounded.
+func (p Partition) Contains(d LocalDate) bool {
+	return !d.Before(p.From) && (p.To.IsZero() || d.Before(p.To))
+}
+
+// Partitioner generates partitions of a table. names are Table + "_" + suffix.
+type Partitioner struct {
+	Table       string
+	Granularity PartitionGranularity
+}
+
+// Partition returns the partition which the localDate belongs to.
+// An error is returned when the granularity is unknown or the localDate is invalid or after MaxPartitionYear.
+func (p Partitioner) Partition(d LocalDate) (Partition, error) {
+	g, err := p.Granularity.Valid()
+	if err != nil {
+		return Partition{}, err
+	}
+	if _, err := d.Valid(); err != nil {
+		return Partition{}, err
+	}
+	if MaxPartitionYear < d.Year {
+		return Partition{}, newRangeError("partition", "year", d.Year, MinYear, MaxPartitionYear)
+	}
+	var from, to LocalDate
+	switch g {
+	case PartitionMonthly:
+		ym := YearMonthFromDate(d)
+		from, to = ym.Period().Start, ym.PlusMonths(1).Period().Start
+	default:
+		from, to = d, addDays(d, 1)
+	}
+	tm := from.ToTimeUtc()
+	return Partition{Name: p.Table + "_" + tm.Format(g.Format().String()), From: from, To: to}, nil
+}
+
+// Partitions returns the partitions which overlap the period, ordered by From.
+func (p Partitioner) Partitions(period LocalDatePeriod) ([]Partition, error) {
+	partitions := make([]Partition, 0)
+	if period.Start.IsZero() || period.End.IsZero() {
+		return partitions, nil
+	}
+	for d := period.Start; !d.After(period.End); {
+		partition, err := p.Partition(d)
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+		d = partition.To
+	}
+	return partitions, nil
+}
+
+// Ahead returns the partition of today and the next n partitions to create ahead. negative n is treated as 0.
+func (p Partitioner) Ahead(today LocalDate, n int) ([]Partition, error) {
+	if n < 0 {
+		n = 0
+	}
+	partitions := make([]Partition, 0, n+1)
+	d := today
+	for i := 0; i <= n; i++ {
+		partition, err := p.Partition(d)
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+		d = partition.To
+	}
+	return partitions, nil
+}
+
+// Parse parses a partition name back into its partition.
+func (p Partitioner) Parse(name string) (Partition, error) {
+	if _, err := p.Granularity.Valid(); err != nil {
+		return Partition{}, err
+	}
+	prefix := p.Table + "_"
+	suffix := strings.TrimPrefix(name, prefix)
+	f := p.Granularity.Format()
+	if !strings.HasPrefix(name, prefix) || len(suffix) != len(f) {
+		return Partition{}, fmt.Errorf("%w: partition name %q is not %s%s", ErrParse, name, prefix, f)
+	}
+	d, err := ParseLocalDate(f, suffix)
+	if err != nil {
+		return Partition{}, fmt.Errorf("%w: partition name %q", err, name)
+	}
+	return p.Partition(d)
+}
+
+// Expired returns the partitions of the names which end on or before the horizon, ordered by From.
+// names which are not partitions of the table and unbounded partitions are ignored.
+func (p Partitioner) Expired(names []string, horizon LocalDate) []Partition {
+	partitions := make([]Partition, 0)
+	for _, name := range names {
+		partition, err := p.Parse(name)
+		if err != nil || partition.To.IsZero() {
+			continue
+		}
+		if !partition.To.After(horizon) {
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].From.Before(partitions[j].From) })
+	return partitions
+}
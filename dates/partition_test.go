@@ -0,0 +1,128 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestPartitioner_Partitions(t *testing.T) {
+	period := LocalDatePeriod{Start: NewLocalDate(2024, 9, 30), End: NewLocalDate(2024, 10, 2)}
+	daily, err := Partitioner{Table: "events", Granularity: PartitionDaily}.Partitions(period)
+	Nil(t, err)
+	Equal(t, []Partition{
+		{Name: "events_20240930", From: NewLocalDate(2024, 9, 30), To: NewLocalDate(2024, 10, 1)},
+		{Name: "events_20241001", From: NewLocalDate(2024, 10, 1), To: NewLocalDate(2024, 10, 2)},
+		{Name: "events_20241002", From: NewLocalDate(2024, 10, 2), To: NewLocalDate(2024, 10, 3)},
+	}, daily)
+	monthly, err := Partitioner{Table: "logs", Granularity: PartitionMonthly}.Partitions(period)
+	Nil(t, err)
+	Equal(t, []Partition{
+		{Name: "logs_202409", From: NewLocalDate(2024, 9, 1), To: NewLocalDate(2024, 10, 1)},
+		{Name: "logs_202410", From: NewLocalDate(2024, 10, 1), To: NewLocalDate(2024, 11, 1)},
+	}, monthly)
+	empty, err := Partitioner{Table: "logs"}.Partitions(LocalDatePeriod{})
+	Nil(t, err)
+	Empty(t, empty)
+
+	partition, err := Partitioner{Table: "logs", Granularity: PartitionMonthly}.Partition(NewLocalDate(2024, 2, 10))
+	Nil(t, err)
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 2, 1), End: NewLocalDate(2024, 2, 29)}, partition.Period())
+	True(t, partition.Contains(NewLocalDate(2024, 2, 29)))
+	False(t, partition.Contains(NewLocalDate(2024, 3, 1)), "toは含まない")
+}
+
+func TestPartitioner_Partition_Invalid(t *testing.T) {
+	_, err := Partitioner{Table: "events", Granularity: PartitionGranularity(9)}.Partition(NewLocalDate(2024, 10, 16))
+	True(t, errors.Is(err, ErrOutOfRangeDate), "未知のgranularityはエラー")
+	_, err = Partitioner{Table: "events", Granularity: PartitionGranularity(9)}.Parse("events_")
+	True(t, errors.Is(err, ErrOutOfRangeDate), "未知のgranularityはエラー")
+	_, err = Partitioner{Table: "events"}.Partition(LocalDate{})
+	True(t, errors.Is(err, ErrOutOfRangeDate), "zero valueはエラー")
+}
+
+func TestPartitioner_Partitions_MaxPartitionYear(t *testing.T) {
+	period := LocalDatePeriod{Start: NewLocalDate(9999, 12, 30), End: NewLocalDate(9999, 12, 31)}
+	daily := Partitioner{Table: "events", Granularity: PartitionDaily}
+	monthly := Partitioner{Table: "logs", Granularity: PartitionMonthly}
+	partitions, err := daily.Partitions(period)
+	Nil(t, err)
+	Len(t, partitions, 2, "9999年の最終日まで作成できる")
+	last, err := monthly.Partition(period.End)
+	Nil(t, err)
+	Equal(t, Partition{Name: "logs_999912", From: NewLocalDate(9999, 12, 1), To: NewLocalDate(10000, 1, 1)}, last)
+	parsed, err := monthly.Parse(last.Name)
+	Nil(t, err)
+	Equal(t, last, parsed, "9999年の名前は戻せる")
+
+	_, err = daily.Ahead(period.End, 1)
+	True(t, errors.Is(err, ErrOutOfRangeDate), "10000年以降の名前は戻せないためエラー")
+	_, err = monthly.Partitions(LocalDatePeriod{Start: period.End, End: NewLocalDate(10000, 1, 1)})
+	True(t, errors.Is(err, ErrOutOfRangeDate), "10000年以降の名前は戻せないためエラー")
+	var rangeErr *RangeError
+	_, err = daily.Partition(NewLocalDate(10000, 1, 1))
+	True(t, errors.As(err, &rangeErr))
+	Equal(t, int64(MaxPartitionYear), rangeErr.Max)
+}
+
+func TestPartitioner_Ahead(t *testing.T) {
+	monthly := Partitioner{Table: "logs", Granularity: PartitionMonthly}
+	partitions, err := monthly.Ahead(NewLocalDate(2024, 11, 30), 2)
+	Nil(t, err)
+	names := make([]string, 0)
+	for _, p := range partitions {
+		names = append(names, p.Name)
+	}
+	Equal(t, []string{"logs_202411", "logs_202412", "logs_202501"}, names)
+
+	partitions, err = monthly.Ahead(NewLocalDate(2024, 11, 30), -5)
+	Nil(t, err)
+	Len(t, partitions, 1, "負数は0として今日のpartitionのみ")
+}
+
+func TestPartitioner_Parse(t *testing.T) {
+	daily := Partitioner{Table: "events", Granularity: PartitionDaily}
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect Partition
+		err    bool
+	}{
+		{
+			title:  "正常",
+			input:  "events_20241016",
+			expect: Partition{Name: "events_20241016", From: NewLocalDate(2024, 10, 16), To: NewLocalDate(2024, 10, 17)},
+		},
+		{title: "別テーブル", input: "logs_20241016", err: true},
+		{title: "月単位の名前", input: "events_202410", err: true},
+		{title: "存在しない日付", input: "events_20240230", err: true},
+		{title: "接尾辞が長い", input: "events_20241016_old", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := daily.Parse(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrParse))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+}
+
+func TestPartitioner_Expired(t *testing.T) {
+	monthly := Partitioner{Table: "logs", Granularity: PartitionMonthly}
+	names := []string{"logs_202410", "logs_202408", "logs_202409", "logs_old", "events_202401"}
+	expired := monthly.Expired(names, NewLocalDate(2024, 10, 1))
+	Equal(t, []Partition{
+		{Name: "logs_202408", From: NewLocalDate(2024, 8, 1), To: NewLocalDate(2024, 9, 1)},
+		{Name: "logs_202409", From: NewLocalDate(2024, 9, 1), To: NewLocalDate(2024, 10, 1)},
+	}, expired, "horizonを含むpartitionは削除しない")
+}
+
+func TestPartition_Unbounded(t *testing.T) {
+	unbounded := Partition{Name: "logs_default", From: NewLocalDate(2024, 10, 1)}
+	True(t, unbounded.Contains(NewLocalDate(9999, 12, 31)), "zero Toは上限なし")
+	Equal(t, LocalDatePeriod{Start: unbounded.From, End: LocalDate{Year: MaxYear, Month: 12, Day: 31}}, unbounded.Period())
+}
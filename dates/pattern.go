@@ -0,0 +1,434 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrPattern pattern is incorrect
+var ErrPattern = errors.New("incorrect pattern")
+
+// Pattern compiled CLDR style pattern. (yyyy-MM-dd, EEE, QQQ)
+//
+//	y     year. yy is 2-digit year of 2000-2099, yyyy is zero padded to 4 digits
+//	M     month. MMM is short name (Oct), MMMM is full name (October)
+//	d     day of month
+//	H     hour of day (0-23)
+//	h     hour of am/pm (1-12)
+//	m     minute
+//	s     second
+//	S     fraction of second. always 0, since this package does not hold fractions
+//	E     day of week. E, EE and EEE are short name (Wed), EEEE is full name (Wednesday)
+//	Q     quarter. QQQ is short name (Q4), QQQQ is full name (4th quarter)
+//	w     ISO 8601 week of year
+//	a     AM or PM
+//	'...' literal. '' is a single quote
+//
+// Names are of the locale, which is LocaleEn by default. (see WithLocale)
+// Other ASCII letters are reserved and must be quoted.
+// A single letter of a numeric field has no padding, and the repeated letters are zero padded to the count.
+// Pattern is safe for concurrent use.
+type Pattern struct {
+	pattern string
+	tokens  []patternToken
+	locale  Locale
+}
+
+type patternToken struct {
+	letter  byte
+	count   int
+	literal string
+}
+
+const patternLetters = "yMdHhmsSEQwa"
+
+// CompilePattern compiles the pattern
+func CompilePattern(pattern string) (*Pattern, error) {
+	tokens := make([]patternToken, 0)
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			literal, n, err := quotedLiteral(pattern, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = appendLiteral(tokens, literal)
+			i += n
+		case isASCIILetter(c):
+			if !strings.ContainsRune(patternLetters, rune(c)) {
+				return nil, fmt.Errorf("%w: reserved letter %q at %d in %q", ErrPattern, c, i, pattern)
+			}
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == c {
+				count++
+			}
+			if err := validPatternCount(c, count); err != nil {
+				return nil, fmt.Errorf("%w at %d in %q", err, i, pattern)
+			}
+			tokens = append(tokens, patternToken{letter: c, count: count})
+			i += count
+		default:
+			tokens = appendLiteral(tokens, pattern[i:i+1])
+			i++
+		}
+	}
+	return &Pattern{pattern: pattern, tokens: tokens, locale: LocaleEn}, nil
+}
+
+// MustCompilePattern CompilePattern, but panics when the pattern is incorrect
+func MustCompilePattern(pattern string) *Pattern {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// WithLocale returns a copy of the pattern which uses names of the locale
+func (p *Pattern) WithLocale(l Locale) *Pattern {
+	return &Pattern{pattern: p.pattern, tokens: p.tokens, locale: l}
+}
+
+// Locale locale of the pattern
+func (p *Pattern) Locale() Locale {
+	return p.locale
+}
+
+// String to string
+func (p *Pattern) String() string {
+	return p.pattern
+}
+
+// FormatDate formats the localDate. time fields are formatted as midnight.
+func (p *Pattern) FormatDate(d LocalDate) string {
+	return p.format(d, LocalTime{})
+}
+
+// FormatDatetime formats the localDatetime.
+func (p *Pattern) FormatDatetime(dt LocalDatetime) string {
+	return p.format(dt.LocalDate, dt.LocalTime)
+}
+
+// FormatTime formats the localTime. date fields are formatted as the zero date.
+func (p *Pattern) FormatTime(t LocalTime) string {
+	return p.format(LocalDate{}, t)
+}
+
+// ParseDate parses the localDate strictly. the pattern must have year, month and day.
+// time fields are parsed, but ignored.
+func (p *Pattern) ParseDate(s string) (LocalDate, error) {
+	fields, err := p.parse(s)
+	if err != nil {
+		return LocalDate{}, err
+	}
+	d, err := fields.localDate(p, s)
+	if err != nil {
+		return LocalDate{}, err
+	}
+	if _, err := fields.localTime(p, s, false); err != nil {
+		return LocalDate{}, err
+	}
+	return d, nil
+}
+
+// ParseDatetime parses the localDatetime strictly. the pattern must have year, month and day.
+// time fields which are not in the pattern are 0.
+func (p *Pattern) ParseDatetime(s string) (LocalDatetime, error) {
+	fields, err := p.parse(s)
+	if err != nil {
+		return LocalDatetime{}, err
+	}
+	d, err := fields.localDate(p, s)
+	if err != nil {
+		return LocalDatetime{}, err
+	}
+	t, err := fields.localTime(p, s, false)
+	if err != nil {
+		return LocalDatetime{}, err
+	}
+	return LocalDatetime{LocalDate: d, LocalTime: t}, nil
+}
+
+// ParseTime parses the localTime strictly. the pattern must have hour, and must not have date fields.
+func (p *Pattern) ParseTime(s string) (LocalTime, error) {
+	fields, err := p.parse(s)
+	if err != nil {
+		return LocalTime{}, err
+	}
+	for _, letter := range "yMdEQw" {
+		if fields.seen(byte(letter)) {
+			return LocalTime{}, fmt.Errorf("%w: pattern %q has date field %q", ErrParse, p, letter)
+		}
+	}
+	return fields.localTime(p, s, true)
+}
+
+func (p *Pattern) format(d LocalDate, t LocalTime) string {
+	var b strings.Builder
+	for _, token := range p.tokens {
+		switch token.letter {
+		case 0:
+			b.WriteString(token.literal)
+		case 'y':
+			if token.count == 2 {
+				b.WriteString(padNumber(int(d.Year%100), 2))
+			} else {
+				b.WriteString(padNumber(int(d.Year), token.count))
+			}
+		case 'M':
+			switch {
+			case token.count == 3 && d.Month != 0:
+				b.WriteString(p.locale.monthName(int(d.Month), false))
+			case token.count == 4 && d.Month != 0:
+				b.WriteString(p.locale.monthName(int(d.Month), true))
+			case token.count <= 2:
+				b.WriteString(padNumber(int(d.Month), token.count))
+			}
+		case 'd':
+			b.WriteString(padNumber(int(d.Day), token.count))
+		case 'H':
+			b.WriteString(padNumber(int(t.Hour), token.count))
+		case 'h':
+			b.WriteString(padNumber(hourOfAmPm(t.Hour), token.count))
+		case 'm':
+			b.WriteString(padNumber(int(t.Minute), token.count))
+		case 's':
+			b.WriteString(padNumber(int(t.Second), token.count))
+		case 'S':
+			b.WriteString(padNumber(0, token.count))
+		case 'E':
+			if d.IsZero() {
+				continue
+			}
+			b.WriteString(p.locale.weekdayName(int(d.Weekday()), token.count == 4))
+		case 'Q':
+			q := 0
+			if !d.IsZero() {
+				q = d.Quarter()
+			}
+			if token.count >= 3 {
+				b.WriteString(p.locale.quarterName(q, token.count == 4))
+			} else {
+				b.WriteString(padNumber(q, token.count))
+			}
+		case 'w':
+			w := 0
+			if !d.IsZero() {
+				_, w = d.ToTimeUtc().ISOWeek()
+			}
+			b.WriteString(padNumber(w, token.count))
+		case 'a':
+			b.WriteString(p.locale.amPm(t.Hour))
+		}
+	}
+	return b.String()
+}
+
+// patternFields values parsed by the pattern
+type patternFields struct {
+	values map[byte]int
+}
+
+func (f patternFields) seen(letter byte) bool {
+	_, ok := f.values[letter]
+	return ok
+}
+
+func (p *Pattern) parse(s string) (patternFields, error) {
+	fields := patternFields{values: make(map[byte]int)}
+	pos := 0
+	for _, token := range p.tokens {
+		if token.letter == 0 {
+			if !strings.HasPrefix(s[pos:], token.literal) {
+				return fields, parsePatternError(p, s, pos, fmt.Sprintf("expected %q", token.literal))
+			}
+			pos += len(token.literal)
+			continue
+		}
+		var (
+			value int
+			n     int
+		)
+		switch {
+		case token.letter == 'M' && token.count >= 3:
+			value, n = matchName(s[pos:], 1, 12, func(i int) string { return p.locale.monthName(i, token.count == 4) })
+		case token.letter == 'E':
+			value, n = matchName(s[pos:], 0, 6, func(i int) string { return p.locale.weekdayName(i, token.count == 4) })
+		case token.letter == 'Q' && token.count >= 3:
+			value, n = matchName(s[pos:], 1, 4, func(i int) string { return p.locale.quarterName(i, token.count == 4) })
+		case token.letter == 'a':
+			value, n = matchName(s[pos:], 0, 1, func(i int) string { return p.locale.AmPm[i] })
+		default:
+			minDigits, maxDigits := token.count, token.count
+			if token.count == 1 {
+				maxDigits = 2
+				if token.letter == 'y' {
+					maxDigits = 9
+				}
+			}
+			value, n = matchDigits(s[pos:], minDigits, maxDigits)
+		}
+		if n == 0 {
+			return fields, parsePatternError(p, s, pos, fmt.Sprintf("expected %s", strings.Repeat(string(token.letter), token.count)))
+		}
+		if token.letter == 'y' && token.count == 2 {
+			value += 2000
+		}
+		if prev, ok := fields.values[token.letter]; ok && prev != value {
+			return fields, parsePatternError(p, s, pos, fmt.Sprintf("conflicting %q", token.letter))
+		}
+		fields.values[token.letter] = value
+		pos += n
+	}
+	if pos != len(s) {
+		return fields, parsePatternError(p, s, pos, "extra text")
+	}
+	return fields, nil
+}
+
+func (f patternFields) localDate(p *Pattern, s string) (LocalDate, error) {
+	for _, letter := range "yMd" {
+		if !f.seen(byte(letter)) {
+			return LocalDate{}, fmt.Errorf("%w: pattern %q has no %q", ErrParse, p, letter)
+		}
+	}
+	year, month, day := f.values['y'], f.values['M'], f.values['d']
+	d := NewLocalDate(year, month, day)
+	if d.Year != uint(year) || d.Month != uint(month) || d.Day != uint(day) {
+		return LocalDate{}, fmt.Errorf("%w: %q is out of range of date", ErrParse, s)
+	}
+	if weekday, ok := f.values['E']; ok && time.Weekday(weekday) != d.Weekday() {
+		return LocalDate{}, fmt.Errorf("%w: day of week of %q does not match", ErrParse, s)
+	}
+	if q, ok := f.values['Q']; ok && q != d.Quarter() {
+		return LocalDate{}, fmt.Errorf("%w: quarter of %q does not match", ErrParse, s)
+	}
+	if w, ok := f.values['w']; ok {
+		if _, week := d.ToTimeUtc().ISOWeek(); w != week {
+			return LocalDate{}, fmt.Errorf("%w: week of %q does not match", ErrParse, s)
+		}
+	}
+	return d, nil
+}
+
+func (f patternFields) localTime(p *Pattern, s string, required bool) (LocalTime, error) {
+	hour, ok := f.values['H']
+	if h, ok12 := f.values['h']; ok12 {
+		if h < 1 || 12 < h {
+			return LocalTime{}, fmt.Errorf("%w: hour of am/pm of %q is out of range", ErrParse, s)
+		}
+		hour12 := h % 12
+		if f.values['a'] == 1 {
+			hour12 += 12
+		}
+		if ok && hour != hour12 {
+			return LocalTime{}, fmt.Errorf("%w: hours of %q do not match", ErrParse, s)
+		}
+		hour, ok = hour12, true
+	} else if pm, okA := f.values['a']; okA && ok && (hour >= 12) != (pm == 1) {
+		return LocalTime{}, fmt.Errorf("%w: am/pm of %q does not match", ErrParse, s)
+	}
+	if required && !ok {
+		return LocalTime{}, fmt.Errorf("%w: pattern %q has no hour", ErrParse, p)
+	}
+	t := LocalTime{Hour: uint(hour), Minute: uint(f.values['m']), Second: uint(f.values['s'])}
+	if t.Hour > MaxHourOfDay || t.Minute > MaxMinuteOfHour || t.Second > MaxSecOfMinute {
+		return LocalTime{}, fmt.Errorf("%w: %q is out of range of time", ErrParse, s)
+	}
+	return t, nil
+}
+
+func parsePatternError(p *Pattern, s string, pos int, reason string) error {
+	return &ParseError{Input: s, Format: Format(p.pattern), Offset: pos, Err: errors.New(reason)}
+}
+
+func validPatternCount(c byte, count int) error {
+	max := 2
+	switch c {
+	case 'y', 'S':
+		max = 9
+	case 'M', 'E', 'Q':
+		max = 4
+	case 'a':
+		max = 1
+	}
+	if count > max {
+		return fmt.Errorf("%w: too many %q", ErrPattern, c)
+	}
+	return nil
+}
+
+func quotedLiteral(pattern string, start int) (string, int, error) {
+	if start+1 < len(pattern) && pattern[start+1] == '\'' {
+		return "'", 2, nil
+	}
+	var b strings.Builder
+	for i := start + 1; i < len(pattern); i++ {
+		if pattern[i] != '\'' {
+			b.WriteByte(pattern[i])
+			continue
+		}
+		if i+1 < len(pattern) && pattern[i+1] == '\'' {
+			b.WriteByte('\'')
+			i++
+			continue
+		}
+		return b.String(), i - start + 1, nil
+	}
+	return "", 0, fmt.Errorf("%w: unterminated quote at %d in %q", ErrPattern, start, pattern)
+}
+
+func appendLiteral(tokens []patternToken, literal string) []patternToken {
+	if last := len(tokens) - 1; last >= 0 && tokens[last].letter == 0 {
+		tokens[last].literal += literal
+		return tokens
+	}
+	return append(tokens, patternToken{literal: literal})
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func padNumber(n, width int) string {
+	s := strconv.Itoa(n)
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat("0", width-len(s)) + s
+}
+
+func hourOfAmPm(hour uint) int {
+	if h := int(hour % 12); h != 0 {
+		return h
+	}
+	return 12
+}
+
+// matchName returns the value and the length of the longest name of min..max at the head of s
+func matchName(s string, min, max int, name func(int) string) (int, int) {
+	value, n := 0, 0
+	for i := min; i <= max; i++ {
+		if candidate := name(i); len(candidate) > n && strings.HasPrefix(s, candidate) {
+			value, n = i, len(candidate)
+		}
+	}
+	return value, n
+}
+
+// matchDigits returns the value and the length of minDigits..maxDigits digits at the head of s
+func matchDigits(s string, minDigits, maxDigits int) (int, int) {
+	n := 0
+	for n < len(s) && n < maxDigits && '0' <= s[n] && s[n] <= '9' {
+		n++
+	}
+	if n < minDigits {
+		return 0, 0
+	}
+	value, _ := strconv.Atoi(s[:n])
+	return value, n
+}
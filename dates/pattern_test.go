@@ -0,0 +1,114 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestCompilePattern(t *testing.T) {
+	for _, table := range []struct {
+		title   string
+		pattern string
+		err     bool
+	}{
+		{title: "日付", pattern: "yyyy-MM-dd"},
+		{title: "クォートのリテラル", pattern: "yyyy'年'M'月'd'日'"},
+		{title: "クォート自身", pattern: "h 'o''clock' a"},
+		{title: "予約文字", pattern: "yyyy-MM-dd G", err: true},
+		{title: "閉じていないクォート", pattern: "yyyy 'at", err: true},
+		{title: "桁数が多すぎる", pattern: "MMMMM", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			p, err := CompilePattern(table.pattern)
+			if table.err {
+				True(t, errors.Is(err, ErrPattern))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.pattern, p.String())
+		})
+	}
+	Panics(t, func() { MustCompilePattern("X") })
+}
+
+func TestPattern_Format(t *testing.T) {
+	dt := NewLocalDatetime(2024, 10, 6, 9, 5, 3)
+	for _, table := range []struct {
+		pattern string
+		expect  string
+	}{
+		{pattern: "yyyy-MM-dd HH:mm:ss.SSS", expect: "2024-10-06 09:05:03.000"},
+		{pattern: "yy/M/d H:m:s", expect: "24/10/6 9:5:3"},
+		{pattern: "EEE, d MMM yyyy", expect: "Sun, 6 Oct 2024"},
+		{pattern: "EEEE, MMMM d", expect: "Sunday, October 6"},
+		{pattern: "yyyy QQQ", expect: "2024 Q4"},
+		{pattern: "QQQQ 'of' yyyy", expect: "4th quarter of 2024"},
+		{pattern: "'W'ww", expect: "W40"},
+		{pattern: "h:mm a", expect: "9:05 AM"},
+		{pattern: "h 'o''clock'", expect: "9 o'clock"},
+		{pattern: "yyyy'年'M'月'd'日'", expect: "2024年10月6日"},
+	} {
+		t.Run(table.pattern, func(t *testing.T) {
+			Equal(t, table.expect, MustCompilePattern(table.pattern).FormatDatetime(dt))
+		})
+	}
+	p := MustCompilePattern("hh:mm a")
+	Equal(t, "12:00 AM", p.FormatTime(LocalTime{}))
+	Equal(t, "12:30 PM", p.FormatTime(LocalTime{Hour: 12, Minute: 30}))
+	Equal(t, "11:59 PM", p.FormatTime(LocalTime{Hour: 23, Minute: 59}))
+	Equal(t, "2024-10-06 00:00", MustCompilePattern("yyyy-MM-dd HH:mm").FormatDate(dt.LocalDate), "日付の時刻は0時")
+}
+
+func TestPattern_Parse(t *testing.T) {
+	for _, table := range []struct {
+		title   string
+		pattern string
+		input   string
+		expect  LocalDatetime
+		err     bool
+	}{
+		{title: "日時", pattern: "yyyy-MM-dd HH:mm:ss", input: "2024-10-06 09:05:03", expect: NewLocalDatetime(2024, 10, 6, 9, 5, 3)},
+		{title: "区切りなし", pattern: "yyyyMMddHHmmss", input: "20241006090503", expect: NewLocalDatetime(2024, 10, 6, 9, 5, 3)},
+		{title: "1文字は1桁も許容", pattern: "y/M/d", input: "2024/1/6", expect: NewLocalDatetime(2024, 1, 6, 0, 0, 0)},
+		{title: "名前", pattern: "EEEE, MMMM d, yyyy", input: "Sunday, October 6, 2024", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "午後", pattern: "yyyy-MM-dd h:mm a", input: "2024-10-06 12:15 PM", expect: NewLocalDatetime(2024, 10, 6, 12, 15, 0)},
+		{title: "午前12時", pattern: "yyyy-MM-dd h:mm a", input: "2024-10-06 12:15 AM", expect: NewLocalDatetime(2024, 10, 6, 0, 15, 0)},
+		{title: "小数秒は無視", pattern: "yyyy-MM-dd HH:mm:ss.SSS", input: "2024-10-06 09:05:03.999", expect: NewLocalDatetime(2024, 10, 6, 9, 5, 3)},
+		{title: "2桁年", pattern: "yyMMdd", input: "241006", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "MMは2桁必須", pattern: "yyyy-MM-dd", input: "2024-1-06", err: true},
+		{title: "曜日が一致しない", pattern: "EEE yyyy-MM-dd", input: "Mon 2024-10-06", err: true},
+		{title: "四半期が一致しない", pattern: "yyyy-MM-dd QQQ", input: "2024-10-06 Q3", err: true},
+		{title: "週が一致しない", pattern: "yyyy-MM-dd 'W'ww", input: "2024-10-06 W41", err: true},
+		{title: "存在しない日付", pattern: "yyyy-MM-dd", input: "2023-02-29", err: true},
+		{title: "範囲外の時刻", pattern: "yyyy-MM-dd HH:mm", input: "2024-10-06 24:00", err: true},
+		{title: "余分な文字", pattern: "yyyy-MM-dd", input: "2024-10-06Z", err: true},
+		{title: "日がない", pattern: "yyyy-MM", input: "2024-10", err: true},
+		{title: "大文字小文字を区別", pattern: "d MMM yyyy", input: "6 oct 2024", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := MustCompilePattern(table.pattern).ParseDatetime(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrParse))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		d, err := MustCompilePattern("dd.MM.yyyy").ParseDate("06.10.2024")
+		Nil(t, err)
+		Equal(t, NewLocalDate(2024, 10, 6), d)
+	}
+	{
+		_, err := MustCompilePattern("hh:mm:ss a").ParseTime("00:00:00 AM")
+		True(t, errors.Is(err, ErrParse), "hは1-12")
+		tm, err := MustCompilePattern("HH:mm").ParseTime("00:30")
+		Nil(t, err)
+		Equal(t, LocalTime{Minute: 30}, tm)
+		_, err = MustCompilePattern("yyyy HH:mm").ParseTime("2024 00:30")
+		True(t, errors.Is(err, ErrParse), "時刻に日付は含められない")
+	}
+}
@@ -0,0 +1,248 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+// ErrMultidimensionalArray array of PostgreSQL has more than one dimension
+var ErrMultidimensionalArray = errors.New("multidimensional array is not supported")
+
+// LocalDateArray localDates as date[] of PostgreSQL.
+// NULL elements are zero localDates, as MarshalJSON of LocalDate.
+//
+//	{2024-01-01,NULL} ---> [2024-01-01, zero]
+type LocalDateArray []LocalDate
+
+// LocalDatetimeArray localDatetimes as timestamp[] of PostgreSQL.
+// NULL elements are zero localDatetimes, as MarshalJSON of LocalDatetime.
+//
+//	{"2024-01-01 00:00:00",NULL} ---> [2024-01-01 00:00:00, zero]
+type LocalDatetimeArray []LocalDatetime
+
+// pgArrayElement an element of an array literal
+type pgArrayElement struct {
+	value  string
+	null   bool
+	offset int
+}
+
+// Value for go-sql-driver. nil is NULL
+func (a LocalDateArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, d := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if d.IsZero() {
+			b.WriteString("NULL")
+		} else {
+			b.WriteString(d.String())
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan for go-sql-driver. text array literal
+func (a *LocalDateArray) Scan(value interface{}) error {
+	if a == nil || value == nil {
+		return &ScanError{Type: "localDateArray", Value: value, Err: errNilValue}
+	}
+	s, ok := pgArrayText(value)
+	if !ok {
+		return &ScanError{Type: "localDateArray", Value: value, Err: errUnsupportedType}
+	}
+	elements, err := parsePgArray(s)
+	if err != nil {
+		return newScanError("localDateArray", value, err)
+	}
+	array := make(LocalDateArray, len(elements))
+	for i, e := range elements {
+		if e.null {
+			continue
+		}
+		d, modifier, err := decodePgDateText(e.value)
+		if err == nil && modifier != Finite {
+			err = &ParseError{Input: e.value, Offset: 0, Err: errInfinity}
+		}
+		if err != nil {
+			return newScanError("localDateArray", value, relativeParseError(s, e.offset, err))
+		}
+		array[i] = d
+	}
+	*a = array
+	return nil
+}
+
+// Value for go-sql-driver. nil is NULL
+func (a LocalDatetimeArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, dt := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if dt.IsZero() {
+			b.WriteString("NULL")
+		} else {
+			b.WriteString(`"` + dt.String() + `"`)
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan for go-sql-driver. text array literal. fractional seconds are truncated.
+func (a *LocalDatetimeArray) Scan(value interface{}) error {
+	if a == nil || value == nil {
+		return &ScanError{Type: "localDatetimeArray", Value: value, Err: errNilValue}
+	}
+	s, ok := pgArrayText(value)
+	if !ok {
+		return &ScanError{Type: "localDatetimeArray", Value: value, Err: errUnsupportedType}
+	}
+	elements, err := parsePgArray(s)
+	if err != nil {
+		return newScanError("localDatetimeArray", value, err)
+	}
+	array := make(LocalDatetimeArray, len(elements))
+	for i, e := range elements {
+		if e.null {
+			continue
+		}
+		dt, modifier, err := decodePgTimestampText(e.value)
+		if err == nil && modifier != Finite {
+			err = &ParseError{Input: e.value, Offset: 0, Err: errInfinity}
+		}
+		if err != nil {
+			return newScanError("localDatetimeArray", value, relativeParseError(s, e.offset, err))
+		}
+		array[i] = dt
+	}
+	*a = array
+	return nil
+}
+
+func pgArrayText(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	}
+	return "", false
+}
+
+// parsePgArray parses one-dimensional array literal.
+// elements may be quoted by double quotes and escaped by backslash, and unquoted NULL is null.
+// dimension decoration such as [1:2]={...} is accepted for one dimension.
+func parsePgArray(s string) ([]pgArrayElement, error) {
+	i := skipPgArraySpace(s, 0)
+	dimensions := 0
+	for i < len(s) && s[i] == '[' {
+		end := strings.IndexByte(s[i:], ']')
+		if end < 0 {
+			return nil, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+		}
+		dimensions++
+		i += end + 1
+	}
+	if dimensions > 1 {
+		return nil, &ParseError{Input: s, Offset: 0, Err: ErrMultidimensionalArray}
+	}
+	if dimensions == 1 {
+		if i = skipPgArraySpace(s, i); i == len(s) || s[i] != '=' {
+			return nil, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+		}
+		i = skipPgArraySpace(s, i+1)
+	}
+	if i == len(s) || s[i] != '{' {
+		return nil, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+	}
+	elements := make([]pgArrayElement, 0)
+	if i = skipPgArraySpace(s, i+1); i < len(s) && s[i] == '}' {
+		return elements, pgArrayTrailingError(s, i+1)
+	}
+	for {
+		i = skipPgArraySpace(s, i)
+		if i == len(s) {
+			return nil, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+		}
+		if s[i] == '{' {
+			return nil, &ParseError{Input: s, Offset: i, Err: ErrMultidimensionalArray}
+		}
+		e, next, err := readPgArrayElement(s, i)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, e)
+		if i = skipPgArraySpace(s, next); i == len(s) {
+			return nil, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+		}
+		switch s[i] {
+		case ',':
+			i++
+		case '}':
+			return elements, pgArrayTrailingError(s, i+1)
+		default:
+			return nil, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+		}
+	}
+}
+
+// readPgArrayElement reads an element from s[i:], and returns the index after it.
+func readPgArrayElement(s string, i int) (pgArrayElement, int, error) {
+	quoted := s[i] == '"'
+	if quoted {
+		i++
+	}
+	e := pgArrayElement{offset: i}
+	var b strings.Builder
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			if i++; i == len(s) {
+				return e, i, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+			}
+			b.WriteByte(s[i])
+		case quoted && c == '"':
+			e.value = b.String()
+			return e, i + 1, nil
+		case quoted:
+			b.WriteByte(c)
+		case c == '"' || c == '{':
+			return e, i, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+		case c == ',' || c == '}':
+			e.value = strings.TrimRight(b.String(), " \t\n\r")
+			e.null = strings.EqualFold(e.value, "NULL")
+			return e, i, nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return e, i, &ParseError{Input: s, Offset: i, Err: errUnmatched}
+}
+
+func skipPgArraySpace(s string, i int) int {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n' || s[i] == '\r') {
+		i++
+	}
+	return i
+}
+
+func pgArrayTrailingError(s string, i int) error {
+	if i = skipPgArraySpace(s, i); i != len(s) {
+		return &ParseError{Input: s, Offset: i, Err: errUnmatched}
+	}
+	return nil
+}
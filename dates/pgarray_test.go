@@ -0,0 +1,84 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDateArray_Scan(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect LocalDateArray
+		err    error
+	}{
+		{title: "日付", input: "{2024-01-01,2024-02-29}", expect: LocalDateArray{NewLocalDate(2024, 1, 1), NewLocalDate(2024, 2, 29)}},
+		{title: "NULLはzero", input: "{2024-01-01,NULL,null}", expect: LocalDateArray{NewLocalDate(2024, 1, 1), {}, {}}},
+		{title: "引用符", input: `{"2024-01-01", "2024-02-29" }`, expect: LocalDateArray{NewLocalDate(2024, 1, 1), NewLocalDate(2024, 2, 29)}},
+		{title: "空", input: "{}", expect: LocalDateArray{}},
+		{title: "次元の指定", input: "[1:1]={2024-01-01}", expect: LocalDateArray{NewLocalDate(2024, 1, 1)}},
+		{title: "多次元", input: "{{2024-01-01},{2024-01-02}}", err: ErrMultidimensionalArray},
+		{title: "多次元の次元の指定", input: "[1:1][1:1]={{2024-01-01}}", err: ErrMultidimensionalArray},
+		{title: "引用符のNULLは文字列", input: `{"NULL"}`, err: ErrParse},
+		{title: "閉じ括弧なし", input: "{2024-01-01", err: ErrParse},
+		{title: "余分な文字", input: "{2024-01-01}x", err: ErrParse},
+		{title: "存在しない日", input: "{2023-02-29}", err: ErrOutOfRangeDate},
+		{title: "infinity", input: "{infinity}", err: errInfinity},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var actual LocalDateArray
+			err := actual.Scan(table.input)
+			if table.err != nil {
+				True(t, errors.Is(err, ErrScan))
+				True(t, errors.Is(err, table.err))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		var a LocalDateArray
+		err := a.Scan("{2024-01-01,2024-13-01}")
+		var pe *ParseError
+		True(t, errors.As(err, &pe))
+		Equal(t, "month", pe.Field)
+		Equal(t, 17, pe.Offset, "literal内のoffset")
+
+		True(t, errors.Is(a.Scan(nil), ErrScan))
+		True(t, errors.Is(a.Scan(1), ErrScan))
+	}
+}
+
+func TestLocalDateArray_Value(t *testing.T) {
+	value, err := LocalDateArray{NewLocalDate(2024, 1, 1), {}}.Value()
+	Nil(t, err)
+	Equal(t, "{2024-01-01,NULL}", value)
+
+	value, err = LocalDateArray{}.Value()
+	Nil(t, err)
+	Equal(t, "{}", value)
+
+	value, err = LocalDateArray(nil).Value()
+	Nil(t, err)
+	Nil(t, value, "nilはNULL")
+}
+
+func TestLocalDatetimeArray(t *testing.T) {
+	expect := LocalDatetimeArray{NewLocalDatetime(2024, 1, 1, 9, 0, 0), {}, NewLocalDatetime(2024, 2, 29, 23, 59, 59)}
+	value, err := expect.Value()
+	Nil(t, err)
+	Equal(t, `{"2024-01-01 09:00:00",NULL,"2024-02-29 23:59:59"}`, value)
+
+	var actual LocalDatetimeArray
+	Nil(t, actual.Scan([]byte(value.(string))))
+	Equal(t, expect, actual)
+
+	Nil(t, actual.Scan(`{"2024-01-01 09:00:00.123456",2024-01-01\ 10:00:00}`))
+	Equal(t, LocalDatetimeArray{NewLocalDatetime(2024, 1, 1, 9, 0, 0), NewLocalDatetime(2024, 1, 1, 10, 0, 0)}, actual, "小数は切り捨て, エスケープ")
+
+	True(t, errors.Is(actual.Scan(`{{"2024-01-01 09:00:00"}}`), ErrMultidimensionalArray))
+	True(t, errors.Is(actual.Scan(`{"2024-01-01 24:00:00"}`), ErrOutOfRangeDate))
+}
@@ -0,0 +1,378 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrEmptyRange range of PostgreSQL is empty. use PgDateRange or PgTsRange for ranges which may be empty.
+var ErrEmptyRange = errors.New("empty range")
+
+// pgRangeEmpty literal of empty range
+const pgRangeEmpty = "empty"
+
+// PgDateRange localDatePeriod as daterange of PostgreSQL. Period is inclusive, and ignored when Empty.
+// zero Start or End of Period is an unbounded side, so the zero Period is (,), not NULL. use nulldates.PgDateRange for NULL.
+type PgDateRange struct {
+	Period LocalDatePeriod
+	Empty  bool
+}
+
+// PgTsRange localDatetimePeriod as tsrange of PostgreSQL. Period is half-open [Start, End), and ignored when Empty.
+// zero Start or End of Period is an unbounded side, so the zero Period is (,), not NULL. use nulldates.PgTsRange for NULL.
+type PgTsRange struct {
+	Period LocalDatetimePeriod
+	Empty  bool
+}
+
+// pgRange bounds of a range literal. empty bound is unbounded.
+type pgRange struct {
+	empty          bool
+	lower, upper   string
+	lowerInclusive bool
+	upperInclusive bool
+	// offsets of lower and upper in the literal
+	lowerOffset, upperOffset int
+}
+
+// AppendText appends daterange literal to buf. the upper bound is exclusive as the canonical form of PostgreSQL.
+// Start after End is an error, since it is not empty but incorrect.
+// bounds out of the range of PgDate are RangeError, including End whose next day is not a PgDate.
+//
+//	(2024-01-01, 2024-01-31) ---> [2024-01-01,2024-02-01)
+//	(zero, 2024-01-31)       ---> (,2024-02-01)
+//	(zero, zero)             ---> (,)
+//	Empty                    ---> empty
+func (r PgDateRange) AppendText(buf []byte) ([]byte, error) {
+	if r.Empty {
+		return append(buf, pgRangeEmpty...), nil
+	}
+	p := r.Period
+	if !p.Start.IsZero() && !p.End.IsZero() && p.End.Before(p.Start) {
+		return buf, fmt.Errorf("%w: %s, %s", ErrFutureThanEndDate, p.Start, p.End)
+	}
+	if !p.Start.IsZero() {
+		if _, err := validPgDate(p.Start); err != nil {
+			return buf, err
+		}
+	}
+	var upper LocalDate
+	if !p.End.IsZero() {
+		if _, err := validPgDate(p.End); err != nil {
+			return buf, err
+		}
+		// the exclusive upper bound must also be a PgDate
+		var err error
+		if upper, err = validPgDate(addDays(p.End, 1)); err != nil {
+			return buf, err
+		}
+	}
+	if p.Start.IsZero() {
+		buf = append(buf, "(,"...)
+	} else {
+		buf = append(append(append(buf, '['), p.Start.String()...), ',')
+	}
+	if upper.IsZero() {
+		return append(buf, ')'), nil
+	}
+	return append(append(buf, upper.String()...), ')'), nil
+}
+
+// ParsePgDateRange parses daterange literal. bounds are normalized to inclusive Start and End.
+// unbounded, infinity and -infinity sides are zero, and empty ranges are Empty.
+//
+//	[2024-01-01,2024-02-01) ---> (2024-01-01, 2024-01-31)
+//	(2024-01-01,2024-01-31] ---> (2024-01-02, 2024-01-31)
+//	[2024-01-01,2024-01-01) ---> Empty
+func ParsePgDateRange(s string) (PgDateRange, error) {
+	r, err := parsePgRange(s)
+	if err != nil {
+		return PgDateRange{}, err
+	} else if r.empty {
+		return PgDateRange{Empty: true}, nil
+	}
+	var p LocalDatePeriod
+	if p.Start, err = parsePgRangeDate(s, r.lower, r.lowerOffset); err != nil {
+		return PgDateRange{}, err
+	}
+	if p.End, err = parsePgRangeDate(s, r.upper, r.upperOffset); err != nil {
+		return PgDateRange{}, err
+	}
+	if !p.Start.IsZero() && !r.lowerInclusive {
+		p.Start = addDays(p.Start, 1)
+	}
+	if !p.End.IsZero() && !r.upperInclusive {
+		p.End = addDays(p.End, -1)
+	}
+	if !p.Start.IsZero() && !p.End.IsZero() && p.End.Before(p.Start) {
+		return PgDateRange{Empty: true}, nil
+	}
+	return PgDateRange{Period: p}, nil
+}
+
+// Value for go-sql-driver. daterange literal
+func (r PgDateRange) Value() (driver.Value, error) {
+	b, err := r.AppendText(nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan for go-sql-driver. daterange literal
+func (r *PgDateRange) Scan(value interface{}) error {
+	if r == nil || value == nil {
+		return &ScanError{Type: "pgDateRange", Value: value, Err: errNilValue}
+	}
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return &ScanError{Type: "pgDateRange", Value: value, Err: errUnsupportedType}
+	}
+	dateRange, err := ParsePgDateRange(s)
+	if err != nil {
+		return newScanError("pgDateRange", value, err)
+	}
+	*r = dateRange
+	return nil
+}
+
+// Value for go-sql-driver. daterange literal, as PgDateRange. the zero period is (,), the unbounded range.
+func (p LocalDatePeriod) Value() (driver.Value, error) {
+	return PgDateRange{Period: p}.Value()
+}
+
+// Scan for go-sql-driver. daterange literal, as PgDateRange. empty range is ErrEmptyRange.
+func (p *LocalDatePeriod) Scan(value interface{}) error {
+	if p == nil || value == nil {
+		return &ScanError{Type: "localDatePeriod", Value: value, Err: errNilValue}
+	}
+	var r PgDateRange
+	if err := r.Scan(value); err != nil {
+		return newScanError("localDatePeriod", value, errors.Unwrap(err))
+	}
+	if r.Empty {
+		return newScanError("localDatePeriod", value, ErrEmptyRange)
+	}
+	*p = r.Period
+	return nil
+}
+
+// AppendText appends tsrange literal to buf. Start not before End is an error, since it is not empty but incorrect.
+// bounds out of the range of PgTimestamp are RangeError.
+//
+//	(2024-01-01 00:00:00, 2024-02-01 00:00:00) ---> ["2024-01-01 00:00:00","2024-02-01 00:00:00")
+//	(zero, zero)                               ---> (,)
+func (r PgTsRange) AppendText(buf []byte) ([]byte, error) {
+	if r.Empty {
+		return append(buf, pgRangeEmpty...), nil
+	}
+	p := r.Period
+	if !p.Start.IsZero() && !p.End.IsZero() && !p.End.After(p.Start) {
+		return buf, fmt.Errorf("%w: %s, %s", ErrFutureOrSameDateAsEnd, p.Start, p.End)
+	}
+	for _, bound := range []LocalDatetime{p.Start, p.End} {
+		if bound.IsZero() {
+			continue
+		}
+		if _, err := validPgTimestamp(bound); err != nil {
+			return buf, err
+		}
+	}
+	if p.Start.IsZero() {
+		buf = append(buf, "(,"...)
+	} else {
+		buf = append(append(append(buf, `["`...), p.Start.String()...), `",`...)
+	}
+	if p.End.IsZero() {
+		return append(buf, ')'), nil
+	}
+	return append(append(append(buf, '"'), p.End.String()...), `")`...), nil
+}
+
+// ParsePgTsRange parses tsrange literal. bounds are normalized to half-open [Start, End) by 1 second,
+// since localDatetime has no fractional seconds. fractional seconds of bounds are truncated.
+// unbounded, infinity and -infinity sides are zero, and empty ranges are Empty.
+//
+//	("2024-01-01 00:00:00","2024-01-31 23:59:59"] ---> (2024-01-01 00:00:01, 2024-02-01 00:00:00)
+func ParsePgTsRange(s string) (PgTsRange, error) {
+	r, err := parsePgRange(s)
+	if err != nil {
+		return PgTsRange{}, err
+	} else if r.empty {
+		return PgTsRange{Empty: true}, nil
+	}
+	var p LocalDatetimePeriod
+	if p.Start, err = parsePgRangeDatetime(s, r.lower, r.lowerOffset); err != nil {
+		return PgTsRange{}, err
+	}
+	if p.End, err = parsePgRangeDatetime(s, r.upper, r.upperOffset); err != nil {
+		return PgTsRange{}, err
+	}
+	if !p.Start.IsZero() && !r.lowerInclusive {
+		p.Start = p.Start.plusSeconds(1)
+	}
+	if !p.End.IsZero() && r.upperInclusive {
+		p.End = p.End.plusSeconds(1)
+	}
+	if !p.Start.IsZero() && !p.End.IsZero() && !p.End.After(p.Start) {
+		return PgTsRange{Empty: true}, nil
+	}
+	return PgTsRange{Period: p}, nil
+}
+
+// Value for go-sql-driver. tsrange literal
+func (r PgTsRange) Value() (driver.Value, error) {
+	b, err := r.AppendText(nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan for go-sql-driver. tsrange literal
+func (r *PgTsRange) Scan(value interface{}) error {
+	if r == nil || value == nil {
+		return &ScanError{Type: "pgTsRange", Value: value, Err: errNilValue}
+	}
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return &ScanError{Type: "pgTsRange", Value: value, Err: errUnsupportedType}
+	}
+	tsRange, err := ParsePgTsRange(s)
+	if err != nil {
+		return newScanError("pgTsRange", value, err)
+	}
+	*r = tsRange
+	return nil
+}
+
+// Value for go-sql-driver. tsrange literal, as PgTsRange. the zero period is (,), the unbounded range.
+func (p LocalDatetimePeriod) Value() (driver.Value, error) {
+	return PgTsRange{Period: p}.Value()
+}
+
+// Scan for go-sql-driver. tsrange literal, as PgTsRange. empty range is ErrEmptyRange.
+func (p *LocalDatetimePeriod) Scan(value interface{}) error {
+	if p == nil || value == nil {
+		return &ScanError{Type: "localDatetimePeriod", Value: value, Err: errNilValue}
+	}
+	var r PgTsRange
+	if err := r.Scan(value); err != nil {
+		return newScanError("localDatetimePeriod", value, errors.Unwrap(err))
+	}
+	if r.Empty {
+		return newScanError("localDatetimePeriod", value, ErrEmptyRange)
+	}
+	*p = r.Period
+	return nil
+}
+
+func parsePgRangeDate(s, bound string, offset int) (LocalDate, error) {
+	if bound == "" {
+		return LocalDate{}, nil
+	}
+	if _, ok := pgInfinityOf(bound); ok {
+		return LocalDate{}, nil
+	}
+	d, _, err := decodePgDateText(bound)
+	return d, relativeParseError(s, offset, err)
+}
+
+func parsePgRangeDatetime(s, bound string, offset int) (LocalDatetime, error) {
+	if bound == "" {
+		return LocalDatetime{}, nil
+	}
+	if _, ok := pgInfinityOf(bound); ok {
+		return LocalDatetime{}, nil
+	}
+	dt, _, err := decodePgTimestampText(bound)
+	return dt, relativeParseError(s, offset, err)
+}
+
+// relativeParseError makes the error of a part at offset relative to the whole literal
+func relativeParseError(s string, offset int, err error) error {
+	if pe, ok := err.(*ParseError); ok {
+		relative := &ParseError{Input: s, Format: pe.Format, Field: pe.Field, Offset: -1, Err: pe.Err}
+		if pe.Offset >= 0 {
+			relative.Offset = offset + pe.Offset
+		}
+		return relative
+	}
+	return err
+}
+
+// parsePgRange parses a range literal. bounds may be quoted by double quotes, and escaped by backslash.
+func parsePgRange(s string) (pgRange, error) {
+	t := strings.TrimSpace(s)
+	base := strings.Index(s, t)
+	if strings.EqualFold(t, pgRangeEmpty) {
+		return pgRange{empty: true}, nil
+	}
+	if len(t) < 3 || (t[0] != '[' && t[0] != '(') {
+		return pgRange{}, &ParseError{Input: s, Offset: base, Err: errUnmatched}
+	}
+	r := pgRange{lowerInclusive: t[0] == '[', lowerOffset: base + pgRangeBoundOffset(t, 1)}
+	lower, i, err := readPgRangeBound(t, 1, ',')
+	if err != nil {
+		return pgRange{}, &ParseError{Input: s, Offset: base + i, Err: err}
+	}
+	r.lower, r.upperOffset = lower, base+pgRangeBoundOffset(t, i+1)
+	upper, i, err := readPgRangeBound(t, i+1, ')', ']')
+	if err != nil {
+		return pgRange{}, &ParseError{Input: s, Offset: base + i, Err: err}
+	}
+	if i != len(t)-1 {
+		return pgRange{}, &ParseError{Input: s, Offset: base + i + 1, Err: errUnmatched}
+	}
+	r.upper, r.upperInclusive = upper, t[i] == ']'
+	return r, nil
+}
+
+// pgRangeBoundOffset offset of the content of the bound at t[i:]
+func pgRangeBoundOffset(t string, i int) int {
+	if i < len(t) && t[i] == '"' {
+		return i + 1
+	}
+	return i
+}
+
+// readPgRangeBound reads a bound from t[i:] until one of the terminators, and returns the index of the terminator.
+func readPgRangeBound(t string, i int, terminators ...byte) (string, int, error) {
+	var b strings.Builder
+	quoted := false
+	for ; i < len(t); i++ {
+		c := t[i]
+		switch {
+		case c == '\\':
+			if i++; i == len(t) {
+				return "", i, errUnmatched
+			}
+			b.WriteByte(t[i])
+		case c == '"':
+			if quoted && i+1 < len(t) && t[i+1] == '"' {
+				// "" in quotes is a double quote
+				b.WriteByte('"')
+				i++
+			} else {
+				quoted = !quoted
+			}
+		case !quoted && strings.IndexByte(string(terminators), c) >= 0:
+			return b.String(), i, nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", i, errUnmatched
+}
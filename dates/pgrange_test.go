@@ -0,0 +1,185 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParsePgDateRange(t *testing.T) {
+	jan := LocalDatePeriod{Start: NewLocalDate(2024, 1, 1), End: NewLocalDate(2024, 1, 31)}
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect PgDateRange
+		err    bool
+	}{
+		{title: "[)", input: "[2024-01-01,2024-02-01)", expect: PgDateRange{Period: jan}},
+		{title: "[]", input: "[2024-01-01,2024-01-31]", expect: PgDateRange{Period: jan}},
+		{title: "()", input: "(2023-12-31,2024-02-01)", expect: PgDateRange{Period: jan}},
+		{title: "(]", input: "(2023-12-31,2024-01-31]", expect: PgDateRange{Period: jan}},
+		{title: "引用符", input: `["2024-01-01","2024-02-01")`, expect: PgDateRange{Period: jan}},
+		{title: "前後の空白", input: " [2024-01-01,2024-02-01) ", expect: PgDateRange{Period: jan}},
+		{title: "下限なし", input: "(,2024-02-01)", expect: PgDateRange{Period: LocalDatePeriod{End: NewLocalDate(2024, 1, 31)}}},
+		{title: "上限なし", input: "[2024-01-01,)", expect: PgDateRange{Period: LocalDatePeriod{Start: NewLocalDate(2024, 1, 1)}}},
+		{title: "両方なし", input: "(,)", expect: PgDateRange{}},
+		{title: "infinityは上限なし", input: "[-infinity,infinity]", expect: PgDateRange{}},
+		{title: "empty", input: "empty", expect: PgDateRange{Empty: true}},
+		{title: "正規化すると空", input: "[2024-01-01,2024-01-01)", expect: PgDateRange{Empty: true}},
+		{title: "閉じ括弧なし", input: "[2024-01-01,2024-02-01", err: true},
+		{title: "カンマなし", input: "[2024-01-01)", err: true},
+		{title: "余分な文字", input: "[2024-01-01,2024-02-01)x", err: true},
+		{title: "存在しない日", input: "[2024-01-01,2023-02-29)", err: true},
+		{title: "紀元前", input: "[0044-03-15 BC,2024-01-01)", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := ParsePgDateRange(table.input)
+			if table.err {
+				NotNil(t, err)
+				Equal(t, PgDateRange{}, actual)
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		_, err := ParsePgDateRange("[2024-01-01,2024-13-01)")
+		var pe *ParseError
+		True(t, errors.As(err, &pe))
+		Equal(t, "month", pe.Field)
+		Equal(t, 17, pe.Offset, "literal内のoffset")
+		Equal(t, "[2024-01-01,2024-13-01)", pe.Input)
+
+		_, err = ParsePgDateRange(`["2024-01-01","2024-1-01")`)
+		True(t, errors.As(err, &pe))
+		Equal(t, 20, pe.Offset, "引用符の内側のoffset")
+	}
+}
+
+func TestPgDateRange_Value(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  PgDateRange
+		expect string
+	}{
+		{title: "上限はexclusive", input: PgDateRange{Period: LocalDatePeriod{Start: NewLocalDate(2024, 1, 1), End: NewLocalDate(2024, 1, 31)}}, expect: "[2024-01-01,2024-02-01)"},
+		{title: "1日", input: PgDateRange{Period: LocalDatePeriod{Start: NewLocalDate(2024, 2, 29), End: NewLocalDate(2024, 2, 29)}}, expect: "[2024-02-29,2024-03-01)"},
+		{title: "下限なし", input: PgDateRange{Period: LocalDatePeriod{End: NewLocalDate(2024, 1, 31)}}, expect: "(,2024-02-01)"},
+		{title: "上限なし", input: PgDateRange{Period: LocalDatePeriod{Start: NewLocalDate(2024, 1, 1)}}, expect: "[2024-01-01,)"},
+		{title: "empty", input: PgDateRange{Empty: true}, expect: "empty"},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			value, err := table.input.Value()
+			Nil(t, err)
+			Equal(t, table.expect, value)
+
+			var scanned PgDateRange
+			Nil(t, scanned.Scan([]byte(table.expect)))
+			Equal(t, table.input, scanned)
+			if !table.input.Empty {
+				periodValue, err := table.input.Period.Value()
+				Nil(t, err)
+				Equal(t, table.expect, periodValue, "LocalDatePeriodも同じliteral")
+				var period LocalDatePeriod
+				Nil(t, period.Scan(table.expect))
+				Equal(t, table.input.Period, period)
+			}
+		})
+	}
+	{
+		inverted := LocalDatePeriod{Start: NewLocalDate(2024, 1, 2), End: NewLocalDate(2024, 1, 1)}
+		_, err := PgDateRange{Period: inverted}.Value()
+		True(t, errors.Is(err, ErrFutureThanEndDate), "逆転した期間はemptyではなくerror")
+		_, err = inverted.Value()
+		True(t, errors.Is(err, ErrFutureThanEndDate))
+
+		var p LocalDatePeriod
+		True(t, errors.Is(p.Scan("empty"), ErrEmptyRange), "LocalDatePeriodはemptyを表せない")
+		True(t, errors.Is(p.Scan(nil), ErrScan))
+		True(t, errors.Is(p.Scan(1), ErrScan))
+		err = p.Scan("[2024-01-01,2024-02-30)")
+		True(t, errors.Is(err, ErrScan))
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+		var r PgDateRange
+		True(t, errors.Is(r.Scan(nil), ErrScan))
+	}
+	{
+		value, err := LocalDatePeriod{}.Value()
+		Nil(t, err)
+		Equal(t, "(,)", value, "zero valueは上下限なしの範囲")
+		var p LocalDatePeriod
+		Nil(t, p.Scan("(,)"))
+		Equal(t, LocalDatePeriod{}, p)
+
+		var rangeErr *RangeError
+		_, err = LocalDatePeriod{End: LocalDate{Year: MaxYear, Month: 12, Day: 31}}.Value()
+		True(t, errors.As(err, &rangeErr), "MaxYearの翌日は存在しないためerror")
+		_, err = LocalDatePeriod{End: NewLocalDate(5881610, 7, 10)}.Value()
+		True(t, errors.As(err, &rangeErr), "PgDateの最大日の翌日は上限にできない")
+		_, err = LocalDatePeriod{Start: LocalDate{Year: 0, Month: 1, Day: 1}}.Value()
+		True(t, errors.As(err, &rangeErr), "紀元前はPgDateの範囲外")
+		value, err = LocalDatePeriod{End: NewLocalDate(5881610, 7, 9)}.Value()
+		Nil(t, err)
+		Equal(t, "(,5881610-07-10)", value)
+	}
+}
+
+func TestParsePgTsRange(t *testing.T) {
+	jan := LocalDatetimePeriod{Start: NewLocalDatetime(2024, 1, 1, 0, 0, 0), End: NewLocalDatetime(2024, 2, 1, 0, 0, 0)}
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect PgTsRange
+		err    bool
+	}{
+		{title: "[)", input: `["2024-01-01 00:00:00","2024-02-01 00:00:00")`, expect: PgTsRange{Period: jan}},
+		{title: "(]は1秒ずらす", input: `("2023-12-31 23:59:59","2024-01-31 23:59:59"]`, expect: PgTsRange{Period: jan}},
+		{title: "小数は切り捨て", input: `["2024-01-01 00:00:00.5","2024-02-01 00:00:00")`, expect: PgTsRange{Period: jan}},
+		{title: "エスケープ", input: `[2024-01-01\ 00:00:00,2024-02-01\ 00:00:00)`, expect: PgTsRange{Period: jan}},
+		{title: "下限なし", input: `(,"2024-02-01 00:00:00")`, expect: PgTsRange{Period: LocalDatetimePeriod{End: jan.End}}},
+		{title: "上限infinity", input: `["2024-01-01 00:00:00",infinity)`, expect: PgTsRange{Period: LocalDatetimePeriod{Start: jan.Start}}},
+		{title: "empty", input: "EMPTY", expect: PgTsRange{Empty: true}},
+		{title: "正規化すると空", input: `("2024-01-01 00:00:00","2024-01-01 00:00:01")`, expect: PgTsRange{Empty: true}},
+		{title: "引用符が閉じない", input: `["2024-01-01 00:00:00,)`, err: true},
+		{title: "範囲外の時", input: `["2024-01-01 24:00:00",)`, err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := ParsePgTsRange(table.input)
+			if table.err {
+				NotNil(t, err)
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		literal := `["2024-01-01 00:00:00","2024-02-01 00:00:00")`
+		value, err := jan.Value()
+		Nil(t, err)
+		Equal(t, literal, value)
+		value, err = PgTsRange{Period: LocalDatetimePeriod{End: jan.End}}.Value()
+		Nil(t, err)
+		Equal(t, `(,"2024-02-01 00:00:00")`, value)
+		value, err = PgTsRange{Empty: true}.Value()
+		Nil(t, err)
+		Equal(t, "empty", value)
+		_, err = LocalDatetimePeriod{Start: jan.Start, End: jan.Start}.Value()
+		True(t, errors.Is(err, ErrFutureOrSameDateAsEnd), "開始と終了が同じ期間はemptyではなくerror")
+		_, err = LocalDatetimePeriod{End: LocalDatetime{LocalDate: LocalDate{Year: MaxYear, Month: 12, Day: 31}}}.Value()
+		True(t, errors.Is(err, ErrOutOfRangeDate), "PgTimestampの範囲外はerror")
+		value, err = LocalDatetimePeriod{}.Value()
+		Nil(t, err)
+		Equal(t, "(,)", value, "zero valueは上下限なしの範囲")
+
+		var p LocalDatetimePeriod
+		Nil(t, p.Scan(literal))
+		Equal(t, jan, p)
+		True(t, errors.Is(p.Scan("empty"), ErrEmptyRange))
+		var r PgTsRange
+		Nil(t, r.Scan([]byte("empty")))
+		True(t, r.Empty)
+	}
+}
@@ -0,0 +1,370 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrPgBinary invalid binary format of PostgreSQL
+var ErrPgBinary = errors.New("invalid postgres binary format")
+
+// InfinityModifier infinity and -infinity of PostgreSQL date and timestamp
+type InfinityModifier int8
+
+// infinity modifier enums
+const (
+	NegativeInfinity InfinityModifier = -1
+	Finite           InfinityModifier = 0
+	Infinity         InfinityModifier = 1
+)
+
+var _InfinityModifierNameMap = map[InfinityModifier]string{
+	NegativeInfinity: "-infinity",
+	Finite:           "finite",
+	Infinity:         "infinity",
+}
+
+// String to string
+func (m InfinityModifier) String() string {
+	return _InfinityModifierNameMap[m]
+}
+
+// postgres epoch const
+const (
+	// PgEpochDay epoch day of 2000-01-01, the epoch of PostgreSQL
+	PgEpochDay int64 = 10957
+	// PgEpochSecond unix seconds of 2000-01-01 00:00:00
+	PgEpochSecond       int64 = PgEpochDay * SecondsOfDay
+	microsOfSecond      int64 = 1000000
+	pgDateLen                 = 4
+	pgTimestampLen            = 8
+	pgInfinityText            = "infinity"
+	pgNegInfinityText         = "-infinity"
+	pgBCSuffix                = " BC"
+	pgFractionMaxDigits       = 6
+	// pgMinDay days of 0001-01-01 since 2000-01-01
+	pgMinDay int64 = -730119
+	// pgMinSecond seconds of 0001-01-01 00:00:00 since 2000-01-01 00:00:00
+	pgMinSecond = pgMinDay * SecondsOfDay
+)
+
+// text format of PostgreSQL. years after 9999 have more than 4 digits, and the fraction of timestamp is ignored.
+var (
+	pgDateFields = []canonicalField{
+		{name: "year", minDigits: 4, maxDigits: 9},
+		{name: "month", sep: '-', minDigits: 2, maxDigits: 2},
+		{name: "day", sep: '-', minDigits: 2, maxDigits: 2},
+	}
+	pgTimestampFields = append(pgDateFields[:len(pgDateFields):len(pgDateFields)],
+		canonicalField{name: "hour", sep: ' ', minDigits: 2, maxDigits: 2},
+		canonicalField{name: "minute", sep: ':', minDigits: 2, maxDigits: 2},
+		canonicalField{name: "second", sep: ':', minDigits: 2, maxDigits: 2},
+	)
+)
+
+// PgDate localDate as PostgreSQL date. the binary format is int32 days since 2000-01-01.
+// the range is 0001-01-01 to 5881610-07-10 in both the binary and text formats.
+// LocalDate is ignored unless InfinityModifier is Finite.
+type PgDate struct {
+	LocalDate        LocalDate
+	InfinityModifier InfinityModifier
+}
+
+// PgTimestamp localDatetime as PostgreSQL timestamp (without time zone).
+// the binary format is int64 microseconds since 2000-01-01 00:00:00, and microseconds are truncated on decoding.
+// the range is 0001-01-01 00:00:00 to the max of int64 microseconds in both the binary and text formats.
+// LocalDatetime is ignored unless InfinityModifier is Finite.
+type PgTimestamp struct {
+	LocalDatetime    LocalDatetime
+	InfinityModifier InfinityModifier
+}
+
+// AppendBinary appends the binary format to buf
+func (d PgDate) AppendBinary(buf []byte) ([]byte, error) {
+	switch d.InfinityModifier {
+	case Infinity:
+		return binary.BigEndian.AppendUint32(buf, math.MaxInt32), nil
+	case NegativeInfinity:
+		return binary.BigEndian.AppendUint32(buf, 1<<31), nil
+	}
+	date, err := validPgDate(d.LocalDate)
+	if err != nil {
+		return buf, err
+	}
+	return binary.BigEndian.AppendUint32(buf, uint32(int32(date.EpochDay()-PgEpochDay))), nil
+}
+
+// DecodeBinary decodes the binary format. BC dates are out of range, as AppendBinary.
+func (d *PgDate) DecodeBinary(src []byte) error {
+	if len(src) != pgDateLen {
+		return fmt.Errorf("%w: date must be %d bytes, got %d", ErrPgBinary, pgDateLen, len(src))
+	}
+	switch days := int64(int32(binary.BigEndian.Uint32(src))); days {
+	case math.MaxInt32:
+		*d = PgDate{InfinityModifier: Infinity}
+	case math.MinInt32:
+		*d = PgDate{InfinityModifier: NegativeInfinity}
+	default:
+		if days < pgMinDay {
+			return &RangeError{Type: "pgDate", Field: "day", Value: days, Min: pgMinDay, Max: math.MaxInt32 - 1}
+		}
+		*d = PgDate{LocalDate: LocalDateOfEpochDay(days + PgEpochDay)}
+	}
+	return nil
+}
+
+// AppendText appends the text format to buf. yyyy-MM-dd, infinity or -infinity.
+// the range is the same as AppendBinary, and years after 9999 have more digits.
+func (d PgDate) AppendText(buf []byte) ([]byte, error) {
+	if d.InfinityModifier != Finite {
+		return append(buf, d.InfinityModifier.String()...), nil
+	}
+	date, err := validPgDate(d.LocalDate)
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, date.String()...), nil
+}
+
+// DecodeText decodes the text format of DateStyle ISO. BC dates are out of range.
+func (d *PgDate) DecodeText(src []byte) error {
+	date, modifier, err := decodePgDateText(src)
+	if err != nil {
+		return err
+	}
+	*d = PgDate{LocalDate: date, InfinityModifier: modifier}
+	return nil
+}
+
+// Value for go-sql-driver. the text format
+func (d PgDate) Value() (driver.Value, error) {
+	b, err := d.AppendText(nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan for go-sql-driver. the text format and time.Time are accepted.
+func (d *PgDate) Scan(value interface{}) error {
+	if d == nil || value == nil {
+		return &ScanError{Type: "pgDate", Value: value, Err: errNilValue}
+	}
+	var date LocalDate
+	var modifier InfinityModifier
+	var err error
+	switch v := value.(type) {
+	case string:
+		date, modifier, err = decodePgDateText(v)
+	case []byte:
+		date, modifier, err = decodePgDateText(v)
+	case time.Time:
+		date, err = validPgDate(LocalDateFromTime(v))
+	default:
+		err = errUnsupportedType
+	}
+	if err != nil {
+		return newScanError("pgDate", value, err)
+	}
+	*d = PgDate{LocalDate: date, InfinityModifier: modifier}
+	return nil
+}
+
+// AppendBinary appends the binary format to buf
+func (dt PgTimestamp) AppendBinary(buf []byte) ([]byte, error) {
+	switch dt.InfinityModifier {
+	case Infinity:
+		return binary.BigEndian.AppendUint64(buf, math.MaxInt64), nil
+	case NegativeInfinity:
+		return binary.BigEndian.AppendUint64(buf, 1<<63), nil
+	}
+	datetime, err := validPgTimestamp(dt.LocalDatetime)
+	if err != nil {
+		return buf, err
+	}
+	return binary.BigEndian.AppendUint64(buf, uint64((datetime.epochSecond()-PgEpochSecond)*microsOfSecond)), nil
+}
+
+// DecodeBinary decodes the binary format. microseconds are truncated, and BC timestamps are out of range, as AppendBinary.
+func (dt *PgTimestamp) DecodeBinary(src []byte) error {
+	if len(src) != pgTimestampLen {
+		return fmt.Errorf("%w: timestamp must be %d bytes, got %d", ErrPgBinary, pgTimestampLen, len(src))
+	}
+	switch micros := int64(binary.BigEndian.Uint64(src)); micros {
+	case math.MaxInt64:
+		*dt = PgTimestamp{InfinityModifier: Infinity}
+	case math.MinInt64:
+		*dt = PgTimestamp{InfinityModifier: NegativeInfinity}
+	default:
+		seconds := floorDiv(micros, microsOfSecond)
+		if seconds < pgMinSecond {
+			return &RangeError{Type: "pgTimestamp", Field: "microsecond", Value: micros, Min: pgMinSecond * microsOfSecond, Max: math.MaxInt64 - 1}
+		}
+		*dt = PgTimestamp{LocalDatetime: localDatetimeOfEpochSecond(seconds + PgEpochSecond)}
+	}
+	return nil
+}
+
+// AppendText appends the text format to buf. yyyy-MM-dd HH:mm:ss, infinity or -infinity.
+// the range is the same as AppendBinary, and years after 9999 have more digits.
+func (dt PgTimestamp) AppendText(buf []byte) ([]byte, error) {
+	if dt.InfinityModifier != Finite {
+		return append(buf, dt.InfinityModifier.String()...), nil
+	}
+	datetime, err := validPgTimestamp(dt.LocalDatetime)
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, datetime.String()...), nil
+}
+
+// DecodeText decodes the text format of DateStyle ISO. fractional seconds are truncated, and BC timestamps are out of range.
+// zones and trailing text are errors, since timestamp without time zone has no zone.
+func (dt *PgTimestamp) DecodeText(src []byte) error {
+	datetime, modifier, err := decodePgTimestampText(src)
+	if err != nil {
+		return err
+	}
+	*dt = PgTimestamp{LocalDatetime: datetime, InfinityModifier: modifier}
+	return nil
+}
+
+// Value for go-sql-driver. the text format
+func (dt PgTimestamp) Value() (driver.Value, error) {
+	b, err := dt.AppendText(nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan for go-sql-driver. the text format and time.Time are accepted.
+func (dt *PgTimestamp) Scan(value interface{}) error {
+	if dt == nil || value == nil {
+		return &ScanError{Type: "pgTimestamp", Value: value, Err: errNilValue}
+	}
+	var datetime LocalDatetime
+	var modifier InfinityModifier
+	var err error
+	switch v := value.(type) {
+	case string:
+		datetime, modifier, err = decodePgTimestampText(v)
+	case []byte:
+		datetime, modifier, err = decodePgTimestampText(v)
+	case time.Time:
+		datetime, err = validPgTimestamp(LocalDatetimeFromTime(v))
+	default:
+		err = errUnsupportedType
+	}
+	if err != nil {
+		return newScanError("pgTimestamp", value, err)
+	}
+	*dt = PgTimestamp{LocalDatetime: datetime, InfinityModifier: modifier}
+	return nil
+}
+
+func decodePgDateText[T text](src T) (LocalDate, InfinityModifier, error) {
+	if modifier, ok := pgInfinityOf(src); ok {
+		return LocalDate{}, modifier, nil
+	}
+	if err := pgBCError(src, "pgDate"); err != nil {
+		return LocalDate{}, Finite, err
+	}
+	var v [3]canonicalValue
+	if err := parseStrictFields(src, DateHyphen, pgDateFields, v[:]); err != nil {
+		return LocalDate{}, Finite, err
+	}
+	date, err := validPgDate(LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value})
+	if err != nil {
+		return LocalDate{}, Finite, canonicalRangeError(src, DateHyphen, pgDateFields, v[:], err)
+	}
+	return date, Finite, nil
+}
+
+func decodePgTimestampText[T text](src T) (LocalDatetime, InfinityModifier, error) {
+	if modifier, ok := pgInfinityOf(src); ok {
+		return LocalDatetime{}, modifier, nil
+	}
+	if err := pgBCError(src, "pgTimestamp"); err != nil {
+		return LocalDatetime{}, Finite, err
+	}
+	var v [6]canonicalValue
+	i, err := parseFields(src, 0, DateTimeHyphen, pgTimestampFields, v[:])
+	if err != nil {
+		return LocalDatetime{}, Finite, err
+	}
+	if i < len(src) && src[i] == '.' {
+		// fractional seconds of 1 to 6 digits are ignored
+		i++
+		start := i
+		for i < len(src) && i-start < pgFractionMaxDigits && '0' <= src[i] && src[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return LocalDatetime{}, Finite, &ParseError{Input: string(src), Format: DateTimeHyphen, Field: "fraction", Offset: start, Err: errUnmatched}
+		}
+	}
+	if i != len(src) {
+		return LocalDatetime{}, Finite, &ParseError{Input: string(src), Format: DateTimeHyphen, Offset: i, Err: errUnmatched}
+	}
+	datetime, err := validPgTimestamp(LocalDatetime{
+		LocalDate: LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value},
+		LocalTime: LocalTime{Hour: v[3].value, Minute: v[4].value, Second: v[5].value},
+	})
+	if err != nil {
+		return LocalDatetime{}, Finite, canonicalRangeError(src, DateTimeHyphen, pgTimestampFields, v[:], err)
+	}
+	return datetime, Finite, nil
+}
+
+// validPgDate checks the range of PgDate, from 0001-01-01 to the max of int32 days. the same in binary and text.
+func validPgDate(d LocalDate) (LocalDate, error) {
+	date, err := d.Valid()
+	if err != nil {
+		return LocalDate{}, err
+	}
+	if date.Year < 1 {
+		return LocalDate{}, &RangeError{Type: "pgDate", Field: "year", Value: int64(date.Year), Min: 1, Max: int64(MaxYear)}
+	}
+	if days := date.EpochDay() - PgEpochDay; math.MaxInt32 <= days {
+		return LocalDate{}, &RangeError{Type: "pgDate", Field: "day", Value: days, Min: pgMinDay, Max: math.MaxInt32 - 1}
+	}
+	return date, nil
+}
+
+// validPgTimestamp checks the range of PgTimestamp, from 0001-01-01 00:00:00 to the max of int64 microseconds.
+// the same in binary and text.
+func validPgTimestamp(dt LocalDatetime) (LocalDatetime, error) {
+	datetime, err := dt.Valid()
+	if err != nil {
+		return LocalDatetime{}, err
+	}
+	if datetime.LocalDate.Year < 1 {
+		return LocalDatetime{}, &RangeError{Type: "pgTimestamp", Field: "year", Value: int64(datetime.LocalDate.Year), Min: 1, Max: int64(MaxYear)}
+	}
+	if seconds := datetime.epochSecond() - PgEpochSecond; math.MaxInt64/microsOfSecond < seconds {
+		return LocalDatetime{}, &RangeError{Type: "pgTimestamp", Field: "second", Value: seconds, Min: pgMinSecond, Max: math.MaxInt64 / microsOfSecond}
+	}
+	return datetime, nil
+}
+
+func pgInfinityOf[T text](src T) (InfinityModifier, bool) {
+	switch string(src) {
+	case pgInfinityText:
+		return Infinity, true
+	case pgNegInfinityText:
+		return NegativeInfinity, true
+	}
+	return Finite, false
+}
+
+func pgBCError[T text](src T, typ string) error {
+	if n := len(src); len(pgBCSuffix) <= n && string(src[n-len(pgBCSuffix):]) == pgBCSuffix {
+		return &RangeError{Type: typ, Field: "year", Min: int64(MinYear), Max: int64(MaxYear)}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package dates
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestPgDate_Binary(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  PgDate
+		expect []byte
+	}{
+		{title: "epoch", input: PgDate{LocalDate: NewLocalDate(2000, 1, 1)}, expect: []byte{0, 0, 0, 0}},
+		{title: "epochの前日", input: PgDate{LocalDate: NewLocalDate(1999, 12, 31)}, expect: []byte{0xff, 0xff, 0xff, 0xff}},
+		{title: "9055日", input: PgDate{LocalDate: NewLocalDate(2024, 10, 16)}, expect: []byte{0, 0, 0x23, 0x5f}},
+		{title: "infinity", input: PgDate{InfinityModifier: Infinity}, expect: []byte{0x7f, 0xff, 0xff, 0xff}},
+		{title: "-infinity", input: PgDate{InfinityModifier: NegativeInfinity}, expect: []byte{0x80, 0, 0, 0}},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := table.input.AppendBinary(nil)
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+
+			var decoded PgDate
+			Nil(t, decoded.DecodeBinary(actual))
+			Equal(t, table.input, decoded)
+		})
+	}
+	{
+		buf, err := PgDate{LocalDate: NewLocalDate(2000, 1, 2)}.AppendBinary([]byte{9})
+		Nil(t, err)
+		Equal(t, []byte{9, 0, 0, 0, 1}, buf, "bufに追加")
+
+		_, err = PgDate{LocalDate: LocalDate{Year: 2023, Month: 2, Day: 29}}.AppendBinary(nil)
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+		_, err = PgDate{LocalDate: LocalDate{Year: MaxYear, Month: 1, Day: 1}}.AppendBinary(nil)
+		True(t, errors.Is(err, ErrOutOfRangeDate), "int32の範囲外")
+
+		var d PgDate
+		True(t, errors.Is(d.DecodeBinary([]byte{0, 0, 0}), ErrPgBinary))
+		True(t, errors.Is(d.DecodeBinary([]byte{0xff, 0xf0, 0, 0}), ErrOutOfRangeDate), "紀元前")
+	}
+}
+
+func TestPgTimestamp_Binary(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  PgTimestamp
+		expect []byte
+	}{
+		{title: "epoch", input: PgTimestamp{LocalDatetime: NewLocalDatetime(2000, 1, 1, 0, 0, 0)}, expect: []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{title: "epochの1秒前", input: PgTimestamp{LocalDatetime: NewLocalDatetime(1999, 12, 31, 23, 59, 59)}, expect: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xf0, 0xbd, 0xc0}},
+		{title: "782384703000000μs", input: PgTimestamp{LocalDatetime: NewLocalDatetime(2024, 10, 16, 9, 5, 3)}, expect: []byte{0, 0x02, 0xc7, 0x93, 0x22, 0x4c, 0xcd, 0xc0}},
+		{title: "infinity", input: PgTimestamp{InfinityModifier: Infinity}, expect: []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{title: "-infinity", input: PgTimestamp{InfinityModifier: NegativeInfinity}, expect: []byte{0x80, 0, 0, 0, 0, 0, 0, 0}},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := table.input.AppendBinary(nil)
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+
+			var decoded PgTimestamp
+			Nil(t, decoded.DecodeBinary(actual))
+			Equal(t, table.input, decoded)
+		})
+	}
+	{
+		var dt PgTimestamp
+		Nil(t, dt.DecodeBinary([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+		Equal(t, NewLocalDatetime(1999, 12, 31, 23, 59, 59), dt.LocalDatetime, "マイクロ秒は切り捨て")
+		True(t, errors.Is(dt.DecodeBinary(nil), ErrPgBinary))
+
+		_, err := PgTimestamp{LocalDatetime: LocalDatetime{LocalDate: LocalDate{Year: 2024, Month: 1, Day: 1}, LocalTime: LocalTime{Hour: 24}}}.AppendBinary(nil)
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+	}
+}
+
+func TestPgDate_Text(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect PgDate
+		err    bool
+	}{
+		{title: "日付", input: "2024-10-16", expect: PgDate{LocalDate: NewLocalDate(2024, 10, 16)}},
+		{title: "infinity", input: "infinity", expect: PgDate{InfinityModifier: Infinity}},
+		{title: "-infinity", input: "-infinity", expect: PgDate{InfinityModifier: NegativeInfinity}},
+		{title: "紀元前", input: "0044-03-15 BC", err: true},
+		{title: "存在しない日", input: "2023-02-29", err: true},
+		{title: "ISO以外", input: "10/16/2024", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var d PgDate
+			err := d.DecodeText([]byte(table.input))
+			if table.err {
+				NotNil(t, err)
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, d)
+			actual, err := d.AppendText(nil)
+			Nil(t, err)
+			Equal(t, table.input, string(actual))
+
+			var scanned PgDate
+			Nil(t, scanned.Scan(table.input))
+			Equal(t, table.expect, scanned)
+			value, err := d.Value()
+			Nil(t, err)
+			Equal(t, table.input, value)
+		})
+	}
+	{
+		var d PgDate
+		Nil(t, d.Scan(time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC)))
+		Equal(t, PgDate{LocalDate: NewLocalDate(2024, 10, 16)}, d)
+		True(t, errors.Is(d.Scan(nil), ErrScan))
+		True(t, errors.Is(d.Scan(int64(1)), ErrScan))
+	}
+}
+
+func TestPgTimestamp_Text(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  string
+		expect PgTimestamp
+		err    bool
+	}{
+		{title: "日時", input: "2024-10-16 09:05:03", expect: PgTimestamp{LocalDatetime: NewLocalDatetime(2024, 10, 16, 9, 5, 3)}},
+		{title: "小数は切り捨て", input: "2024-10-16 09:05:03.999999", expect: PgTimestamp{LocalDatetime: NewLocalDatetime(2024, 10, 16, 9, 5, 3)}},
+		{title: "1桁の小数", input: "2024-10-16 09:05:03.5", expect: PgTimestamp{LocalDatetime: NewLocalDatetime(2024, 10, 16, 9, 5, 3)}},
+		{title: "infinity", input: "infinity", expect: PgTimestamp{InfinityModifier: Infinity}},
+		{title: "-infinity", input: "-infinity", expect: PgTimestamp{InfinityModifier: NegativeInfinity}},
+		{title: "紀元前", input: "0044-03-15 12:00:00 BC", err: true},
+		{title: "範囲外の時", input: "2024-10-16 24:00:00", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var dt PgTimestamp
+			err := dt.DecodeText([]byte(table.input))
+			if table.err {
+				True(t, errors.Is(err, ErrOutOfRangeDate))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, dt)
+
+			var scanned PgTimestamp
+			Nil(t, scanned.Scan([]byte(table.input)))
+			Equal(t, table.expect, scanned)
+		})
+	}
+	for _, input := range []string{
+		"2024-10-16 09:05:03+09",
+		"2024-10-16 09:05:03.123456+09",
+		"2024-10-16 09:05:03x",
+		"2024-10-16 09:05:03.",
+		"2024-10-16 09:05:03.1234567",
+	} {
+		var dt PgTimestamp
+		True(t, errors.Is(dt.DecodeText([]byte(input)), ErrParse), "zoneや後ろの文字はerror: %s", input)
+	}
+	{
+		text, err := PgTimestamp{InfinityModifier: NegativeInfinity}.AppendText([]byte("x"))
+		Nil(t, err)
+		Equal(t, "x-infinity", string(text))
+		value, err := PgTimestamp{LocalDatetime: NewLocalDatetime(2024, 10, 16, 9, 5, 3)}.Value()
+		Nil(t, err)
+		Equal(t, "2024-10-16 09:05:03", value)
+	}
+}
+
+func TestPgDate_RoundTripEdges(t *testing.T) {
+	maxDate := LocalDateOfEpochDay(PgEpochDay + math.MaxInt32 - 1)
+	for _, table := range []struct {
+		title string
+		input LocalDate
+		err   bool
+	}{
+		{title: "最小の日付", input: NewLocalDate(1, 1, 1)},
+		{title: "5桁の年", input: NewLocalDate(10000, 1, 1)},
+		{title: "最大の日付", input: maxDate},
+		{title: "0年は紀元前", input: LocalDate{Year: 0, Month: 12, Day: 31}, err: true},
+		{title: "最大の日付の翌日", input: addDays(maxDate, 1), err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			d := PgDate{LocalDate: table.input}
+			binaryBuf, binaryErr := d.AppendBinary(nil)
+			textBuf, textErr := d.AppendText(nil)
+			if table.err {
+				True(t, errors.Is(binaryErr, ErrOutOfRangeDate), "binaryとtextで同じ範囲")
+				True(t, errors.Is(textErr, ErrOutOfRangeDate), "binaryとtextで同じ範囲")
+				_, err := d.Value()
+				True(t, errors.Is(err, ErrOutOfRangeDate))
+				return
+			}
+			Nil(t, binaryErr)
+			Nil(t, textErr)
+			var fromBinary, fromText PgDate
+			Nil(t, fromBinary.DecodeBinary(binaryBuf))
+			Nil(t, fromText.DecodeText(textBuf))
+			Equal(t, d, fromBinary)
+			Equal(t, d, fromText)
+		})
+	}
+	var d PgDate
+	True(t, errors.Is(d.DecodeText([]byte("0000-12-31")), ErrOutOfRangeDate), "0年はtextでも紀元前")
+	True(t, errors.Is(d.DecodeText([]byte("5881610-07-11")), ErrOutOfRangeDate), "int32の範囲外はtextでも範囲外")
+}
+
+func TestPgTimestamp_RoundTripEdges(t *testing.T) {
+	maxDatetime := localDatetimeOfEpochSecond(PgEpochSecond + math.MaxInt64/microsOfSecond)
+	for _, table := range []struct {
+		title string
+		input LocalDatetime
+		err   bool
+	}{
+		{title: "最小の日時", input: NewLocalDatetime(1, 1, 1, 0, 0, 0)},
+		{title: "5桁の年", input: NewLocalDatetime(10000, 1, 1, 0, 0, 0)},
+		{title: "最大の日時", input: maxDatetime},
+		{title: "0年は紀元前", input: LocalDatetime{LocalDate: LocalDate{Year: 0, Month: 12, Day: 31}, LocalTime: LocalTime{Hour: 23}}, err: true},
+		{title: "最大の日時の1秒後", input: maxDatetime.plusSeconds(1), err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			dt := PgTimestamp{LocalDatetime: table.input}
+			binaryBuf, binaryErr := dt.AppendBinary(nil)
+			textBuf, textErr := dt.AppendText(nil)
+			if table.err {
+				True(t, errors.Is(binaryErr, ErrOutOfRangeDate), "binaryとtextで同じ範囲")
+				True(t, errors.Is(textErr, ErrOutOfRangeDate), "binaryとtextで同じ範囲")
+				_, err := dt.Value()
+				True(t, errors.Is(err, ErrOutOfRangeDate))
+				return
+			}
+			Nil(t, binaryErr)
+			Nil(t, textErr)
+			var fromBinary, fromText PgTimestamp
+			Nil(t, fromBinary.DecodeBinary(binaryBuf))
+			Nil(t, fromText.DecodeText(textBuf))
+			Equal(t, dt, fromBinary)
+			Equal(t, dt, fromText)
+		})
+	}
+	var dt PgTimestamp
+	True(t, errors.Is(dt.DecodeText([]byte("0000-12-31 23:00:00")), ErrOutOfRangeDate), "0年はtextでも紀元前")
+}
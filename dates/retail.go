@@ -0,0 +1,253 @@
+package dates
+
+import (
+	"fmt"
+	"time"
+)
+
+// retail calendar const
+const (
+	DaysOfWeek             = 7
+	PeriodsOfRetailYear    = 12
+	PeriodsOfRetailQuarter = 3
+)
+
+// RetailPattern weeks per period in a retail quarter
+type RetailPattern int
+
+// retail pattern enums
+const (
+	Pattern445 RetailPattern = iota
+	Pattern454
+	Pattern544
+)
+
+var _RetailPatternWeeksMap = map[RetailPattern][PeriodsOfRetailQuarter]int{
+	Pattern445: {4, 4, 5},
+	Pattern454: {4, 5, 4},
+	Pattern544: {5, 4, 4},
+}
+
+// String to string
+func (p RetailPattern) String() string {
+	weeks := _RetailPatternWeeksMap[p]
+	return fmt.Sprintf("%d-%d-%d", weeks[0], weeks[1], weeks[2])
+}
+
+// RetailYearEnd rule to decide the last day of a retail year
+type RetailYearEnd int
+
+// retail year end enums
+const (
+	// RetailYearEndNearest the end weekday nearest to the last day of the end month.(NRF)
+	RetailYearEndNearest RetailYearEnd = iota
+	// RetailYearEndLast the last end weekday in the end month.
+	RetailYearEndLast
+)
+
+// RetailCalendar retail calendar configuration such as NRF 4-5-4 calendar.
+//
+// A retail year is named after the calendar year containing most of its days.
+// (NRF: the retail year 2024 is from 2024-02-04 to 2025-02-01)
+// In a 53-week year, the extra week is added to Week53Period. (0 is treated as the last period)
+type RetailCalendar struct {
+	Pattern      RetailPattern
+	EndMonth     uint
+	EndWeekday   time.Weekday
+	YearEnd      RetailYearEnd
+	Week53Period uint
+}
+
+// NRFCalendar National Retail Federation 4-5-4 calendar
+var NRFCalendar = RetailCalendar{
+	Pattern:      Pattern454,
+	EndMonth:     1,
+	EndWeekday:   time.Saturday,
+	YearEnd:      RetailYearEndNearest,
+	Week53Period: PeriodsOfRetailYear,
+}
+
+// Valid validate retailCalendar
+func (c RetailCalendar) Valid() (RetailCalendar, error) {
+	if _, ok := _RetailPatternWeeksMap[c.Pattern]; !ok {
+		return c, fmt.Errorf("%w: retailCalendar pattern: %d", ErrOutOfRangeDate, c.Pattern)
+	}
+	if c.EndMonth < MinMonthOfYear || MaxMonthOfYear < c.EndMonth {
+		return c, fmt.Errorf("%w: retailCalendar end month: %d", ErrOutOfRangeDate, c.EndMonth)
+	}
+	if c.EndWeekday < time.Sunday || time.Saturday < c.EndWeekday {
+		return c, fmt.Errorf("%w: retailCalendar end weekday: %d", ErrOutOfRangeDate, c.EndWeekday)
+	}
+	if PeriodsOfRetailYear < c.Week53Period {
+		return c, fmt.Errorf("%w: retailCalendar week53 period: %d", ErrOutOfRangeDate, c.Week53Period)
+	}
+	return c, nil
+}
+
+// Year retail year of the calendar
+func (c RetailCalendar) Year(year int) RetailYear {
+	if year < 1 {
+		return RetailYear{Calendar: c}
+	}
+	return RetailYear{Year: uint(year), Calendar: c}
+}
+
+// RetailDateOf maps the localDate to the retail calendar.
+// zero or invalid localDate and dates before the retail year 1 return empty.
+func (c RetailCalendar) RetailDateOf(d LocalDate) RetailDate {
+	if _, err := d.Valid(); err != nil || d.Before(c.Year(1).Start()) {
+		return RetailDate{}
+	}
+	ry := c.Year(int(d.Year))
+	if d.After(ry.End()) {
+		ry = ry.Plus(1)
+	} else if d.Before(ry.Start()) {
+		ry = ry.Plus(-1)
+	}
+	days := daysBetween(ry.Start(), d)
+	week := days/DaysOfWeek + 1
+	period, weekOfPeriod := 1, week
+	for ; period < PeriodsOfRetailYear; period++ {
+		weeks := ry.weeksOfPeriod(period)
+		if weekOfPeriod <= weeks {
+			break
+		}
+		weekOfPeriod -= weeks
+	}
+	return RetailDate{
+		Year:         ry.Year,
+		Quarter:      uint((period-1)/PeriodsOfRetailQuarter + 1),
+		Period:       uint(period),
+		Week:         uint(week),
+		WeekOfPeriod: uint(weekOfPeriod),
+		DayOfWeek:    uint(days%DaysOfWeek + 1),
+	}
+}
+
+// LocalDate maps the retail year, week of year(1~53) and day of week(1~7) back to the localDate.
+func (c RetailCalendar) LocalDate(year, week, dayOfWeek int) (LocalDate, error) {
+	ry := c.Year(year)
+	if week < 1 || ry.Weeks() < week {
+		return LocalDate{}, fmt.Errorf("%w: retail year %d has no week %d", ErrOutOfRangeDate, year, week)
+	}
+	if dayOfWeek < 1 || DaysOfWeek < dayOfWeek {
+		return LocalDate{}, fmt.Errorf("%w: retail day of week: %d", ErrOutOfRangeDate, dayOfWeek)
+	}
+	return addDays(ry.Start(), (week-1)*DaysOfWeek+dayOfWeek-1), nil
+}
+
+func (c RetailCalendar) endYearOffset() int {
+	// 年の大部分を含む暦年で命名するため, 上半期に終わる場合は翌年に終わる
+	if c.EndMonth <= MaxMonthOfYear/2 {
+		return 1
+	}
+	return 0
+}
+
+func (c RetailCalendar) week53Period() int {
+	if c.Week53Period == 0 {
+		return PeriodsOfRetailYear
+	}
+	return int(c.Week53Period)
+}
+
+// RetailDate a localDate in the retail calendar.
+type RetailDate struct {
+	Year         uint
+	Quarter      uint
+	Period       uint
+	Week         uint
+	WeekOfPeriod uint
+	DayOfWeek    uint
+}
+
+// RetailYear a year of the retail calendar
+type RetailYear struct {
+	Year     uint
+	Calendar RetailCalendar
+}
+
+// End last day of the retail year
+func (ry RetailYear) End() LocalDate {
+	c := ry.Calendar
+	monthEnd := YearMonth{Year: ry.Year + uint(c.endYearOffset()), Month: c.EndMonth}.AtEndOfMonth()
+	diff := (int(monthEnd.Weekday()) - int(c.EndWeekday) + DaysOfWeek) % DaysOfWeek
+	if c.YearEnd == RetailYearEndNearest && diff > DaysOfWeek/2 {
+		return addDays(monthEnd, DaysOfWeek-diff)
+	}
+	return addDays(monthEnd, -diff)
+}
+
+// Start first day of the retail year
+func (ry RetailYear) Start() LocalDate {
+	return addDays(ry.Plus(-1).End(), 1)
+}
+
+// Period returns the period from the first day to the last day of the retail year.(both inclusive)
+func (ry RetailYear) Period() LocalDatePeriod {
+	return LocalDatePeriod{Start: ry.Start(), End: ry.End()}
+}
+
+// Weeks number of weeks in the retail year. 52 or 53
+func (ry RetailYear) Weeks() int {
+	return (daysBetween(ry.Start(), ry.End()) + 1) / DaysOfWeek
+}
+
+// Has53Weeks the retail year has 53 weeks?
+func (ry RetailYear) Has53Weeks() bool {
+	return ry.Weeks() == 53
+}
+
+// Plus retailYear add years
+func (ry RetailYear) Plus(years int) RetailYear {
+	return ry.Calendar.Year(int(ry.Year) + years)
+}
+
+// Week returns the period of the week(1~52 or 53) in the retail year.
+func (ry RetailYear) Week(week int) (LocalDatePeriod, error) {
+	start, err := ry.Calendar.LocalDate(int(ry.Year), week, 1)
+	if err != nil {
+		return LocalDatePeriod{}, err
+	}
+	return LocalDatePeriod{Start: start, End: addDays(start, DaysOfWeek-1)}, nil
+}
+
+// FiscalPeriod returns the period of the retail period(1~12) in the retail year.
+func (ry RetailYear) FiscalPeriod(period int) (LocalDatePeriod, error) {
+	if period < 1 || PeriodsOfRetailYear < period {
+		return LocalDatePeriod{}, fmt.Errorf("%w: retail period: %d", ErrOutOfRangeDate, period)
+	}
+	start := ry.Start()
+	for p := 1; p < period; p++ {
+		start = addDays(start, ry.weeksOfPeriod(p)*DaysOfWeek)
+	}
+	return LocalDatePeriod{Start: start, End: addDays(start, ry.weeksOfPeriod(period)*DaysOfWeek-1)}, nil
+}
+
+// FiscalPeriods returns all retail periods in the retail year in order.
+func (ry RetailYear) FiscalPeriods() LocalDatePeriods {
+	periods := make(LocalDatePeriods, 0, PeriodsOfRetailYear)
+	for p := 1; p <= PeriodsOfRetailYear; p++ {
+		period, _ := ry.FiscalPeriod(p)
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// Quarter returns the period of the retail quarter(1~4) in the retail year.
+func (ry RetailYear) Quarter(quarter int) (LocalDatePeriod, error) {
+	if quarter < 1 || int(QuartersOfYear) < quarter {
+		return LocalDatePeriod{}, fmt.Errorf("%w: retail quarter: %d", ErrOutOfRangeDate, quarter)
+	}
+	first, _ := ry.FiscalPeriod((quarter-1)*PeriodsOfRetailQuarter + 1)
+	last, _ := ry.FiscalPeriod(quarter * PeriodsOfRetailQuarter)
+	return LocalDatePeriod{Start: first.Start, End: last.End}, nil
+}
+
+func (ry RetailYear) weeksOfPeriod(period int) int {
+	weeks := _RetailPatternWeeksMap[ry.Calendar.Pattern][(period-1)%PeriodsOfRetailQuarter]
+	if period == ry.Calendar.week53Period() && ry.Has53Weeks() {
+		weeks++
+	}
+	return weeks
+}
@@ -0,0 +1,123 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestRetailCalendar_Valid(t *testing.T) {
+	_, err := NRFCalendar.Valid()
+	Nil(t, err)
+
+	_, err = RetailCalendar{Pattern: RetailPattern(3), EndMonth: 1}.Valid()
+	True(t, errors.Is(err, ErrOutOfRangeDate), "存在しないpattern")
+
+	_, err = RetailCalendar{EndMonth: 0}.Valid()
+	True(t, errors.Is(err, ErrOutOfRangeDate), "end monthは必須")
+	Equal(t, "4-5-4", Pattern454.String())
+}
+
+func TestRetailYear_NRF(t *testing.T) {
+	for _, table := range []struct {
+		title       string
+		year        int
+		expectStart LocalDate
+		expectEnd   LocalDate
+		expectWeeks int
+	}{
+		{title: "2022年度", year: 2022, expectStart: NewLocalDate(2022, 1, 30), expectEnd: NewLocalDate(2023, 1, 28), expectWeeks: 52},
+		{title: "2023年度は53週", year: 2023, expectStart: NewLocalDate(2023, 1, 29), expectEnd: NewLocalDate(2024, 2, 3), expectWeeks: 53},
+		{title: "2024年度", year: 2024, expectStart: NewLocalDate(2024, 2, 4), expectEnd: NewLocalDate(2025, 2, 1), expectWeeks: 52},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			ry := NRFCalendar.Year(table.year)
+			Equal(t, table.expectStart, ry.Start())
+			Equal(t, table.expectEnd, ry.End())
+			Equal(t, table.expectWeeks, ry.Weeks())
+			Equal(t, LocalDatePeriod{Start: table.expectStart, End: table.expectEnd}, ry.Period())
+		})
+	}
+}
+
+func TestRetailYear_FiscalPeriods(t *testing.T) {
+	{
+		periods := NRFCalendar.Year(2024).FiscalPeriods()
+		Len(t, periods, 12)
+		Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 2, 4), End: NewLocalDate(2024, 3, 2)}, periods[0], "4週")
+		Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 3, 3), End: NewLocalDate(2024, 4, 6)}, periods[1], "5週")
+		Equal(t, LocalDatePeriod{Start: NewLocalDate(2025, 1, 5), End: NewLocalDate(2025, 2, 1)}, periods[11], "4週")
+	}
+	{
+		periods := NRFCalendar.Year(2023).FiscalPeriods()
+		Equal(t, LocalDatePeriod{Start: NewLocalDate(2023, 12, 31), End: NewLocalDate(2024, 2, 3)}, periods[11], "53週の年は最終periodが5週")
+	}
+	{
+		c := NRFCalendar
+		c.Pattern = Pattern445
+		quarter, err := c.Year(2024).Quarter(1)
+		Nil(t, err)
+		Equal(t, LocalDatePeriod{Start: NewLocalDate(2024, 2, 4), End: NewLocalDate(2024, 5, 4)}, quarter)
+		_, err = c.Year(2024).FiscalPeriod(13)
+		True(t, errors.Is(err, ErrOutOfRangeDate))
+	}
+}
+
+func TestRetailCalendar_RetailDateOf(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		date   LocalDate
+		expect RetailDate
+	}{
+		{
+			title:  "年度の初日",
+			date:   NewLocalDate(2024, 2, 4),
+			expect: RetailDate{Year: 2024, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1, DayOfWeek: 1},
+		},
+		{
+			title:  "暦年の1月は前年度",
+			date:   NewLocalDate(2025, 1, 31),
+			expect: RetailDate{Year: 2024, Quarter: 4, Period: 12, Week: 52, WeekOfPeriod: 4, DayOfWeek: 6},
+		},
+		{
+			title:  "暦年の2月でも年度開始前は前年度の53週目",
+			date:   NewLocalDate(2024, 2, 3),
+			expect: RetailDate{Year: 2023, Quarter: 4, Period: 12, Week: 53, WeekOfPeriod: 5, DayOfWeek: 7},
+		},
+		{
+			title:  "5週のperiodの最終週",
+			date:   NewLocalDate(2024, 4, 6),
+			expect: RetailDate{Year: 2024, Quarter: 1, Period: 2, Week: 9, WeekOfPeriod: 5, DayOfWeek: 7},
+		},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual := NRFCalendar.RetailDateOf(table.date)
+			Equal(t, table.expect, actual)
+
+			back, err := NRFCalendar.LocalDate(int(actual.Year), int(actual.Week), int(actual.DayOfWeek))
+			Nil(t, err)
+			Equal(t, table.date, back)
+		})
+	}
+	_, err := NRFCalendar.LocalDate(2024, 53, 1)
+	True(t, errors.Is(err, ErrOutOfRangeDate), "52週の年に53週目は存在しない")
+
+	Equal(t, RetailDate{}, NRFCalendar.RetailDateOf(LocalDate{}), "zero valueはempty")
+	Equal(t, RetailDate{}, NRFCalendar.RetailDateOf(LocalDate{Year: 0, Month: 6, Day: 1}), "retail year 1より前はempty")
+	Equal(t, RetailDate{}, NRFCalendar.RetailDateOf(LocalDate{Year: 2024, Month: 13, Day: 1}), "存在しない日付はempty")
+	first := NRFCalendar.Year(1).Start()
+	Equal(t, RetailDate{Year: 1, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1, DayOfWeek: 1}, NRFCalendar.RetailDateOf(first), "retail year 1の初日")
+	Equal(t, RetailDate{}, NRFCalendar.RetailDateOf(addDays(first, -1)))
+}
+
+func TestRetailYear_LastWeekday(t *testing.T) {
+	c := RetailCalendar{Pattern: Pattern445, EndMonth: 12, EndWeekday: time.Saturday, YearEnd: RetailYearEndLast}
+	ry := c.Year(2024)
+	Equal(t, NewLocalDate(2024, 12, 28), ry.End())
+	Equal(t, NewLocalDate(2023, 12, 31), ry.Start())
+	week, err := ry.Week(1)
+	Nil(t, err)
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2023, 12, 31), End: NewLocalDate(2024, 1, 6)}, week)
+}
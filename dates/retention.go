@@ -0,0 +1,177 @@
+package dates
+
+import (
+	"sort"
+	"time"
+)
+
+// RetentionReason reason why a snapshot is kept or pruned
+type RetentionReason int
+
+// retention reason enums
+const (
+	// RetainDaily kept as the newest snapshot of a day in the daily window
+	RetainDaily RetentionReason = iota
+	// RetainWeekly kept as the newest snapshot of a week in the weekly window
+	RetainWeekly
+	// RetainMonthly kept as the newest snapshot of a month in the monthly window
+	RetainMonthly
+	// RetainYearly kept as the newest snapshot of a year in the yearly window
+	RetainYearly
+	// RetainFuture kept because it is after today
+	RetainFuture
+	// PruneSuperseded pruned because a newer snapshot is kept for the same period
+	PruneSuperseded
+	// PruneOutOfRetention pruned because it is older than every window
+	PruneOutOfRetention
+	// PruneDuplicate pruned because the same date appears more than once
+	PruneDuplicate
+)
+
+var _RetentionReasonNameMap = map[RetentionReason]string{
+	RetainDaily:         "daily",
+	RetainWeekly:        "weekly",
+	RetainMonthly:       "monthly",
+	RetainYearly:        "yearly",
+	RetainFuture:        "future",
+	PruneSuperseded:     "superseded",
+	PruneOutOfRetention: "out of retention",
+	PruneDuplicate:      "duplicate",
+}
+
+// String to string
+func (r RetentionReason) String() string {
+	return _RetentionReasonNameMap[r]
+}
+
+// RetentionPolicy grandfather-father-son retention policy.
+//
+// Each window is counted back from today including the current period.
+// (Daily: 7 keeps today and the previous 6 days, Monthly: 12 keeps this month and the previous 11 months)
+// In each period of a window, the newest snapshot is kept.
+type RetentionPolicy struct {
+	Daily     int
+	Weekly    int
+	Monthly   int
+	Yearly    int
+	WeekStart time.Weekday
+}
+
+// RetentionDecision decision for a snapshot
+type RetentionDecision struct {
+	Date    LocalDate
+	Keep    bool
+	Reasons []RetentionReason
+}
+
+// RetentionDecisions decisions ordered from newest to oldest
+type RetentionDecisions []RetentionDecision
+
+// Evaluate decides which snapshots to keep and which to prune.
+func (p RetentionPolicy) Evaluate(snapshots []LocalDate, today LocalDate) RetentionDecisions {
+	sorted := make([]LocalDate, len(snapshots))
+	copy(sorted, snapshots)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].After(sorted[j]) })
+
+	rules := p.rules(today)
+	claimed := make([]map[LocalDate]bool, len(rules))
+	for i := range claimed {
+		claimed[i] = make(map[LocalDate]bool)
+	}
+
+	decisions := make(RetentionDecisions, 0, len(sorted))
+	for i, d := range sorted {
+		if i > 0 && d.Equal(sorted[i-1]) {
+			decisions = append(decisions, RetentionDecision{Date: d, Reasons: []RetentionReason{PruneDuplicate}})
+			continue
+		}
+		if d.After(today) {
+			decisions = append(decisions, RetentionDecision{Date: d, Keep: true, Reasons: []RetentionReason{RetainFuture}})
+			continue
+		}
+		decision := RetentionDecision{Date: d, Reasons: make([]RetentionReason, 0)}
+		inWindow := false
+		for ri, rule := range rules {
+			period := rule.period(d)
+			if period.Before(rule.oldest) {
+				continue
+			}
+			inWindow = true
+			if !claimed[ri][period] {
+				claimed[ri][period] = true
+				decision.Keep = true
+				decision.Reasons = append(decision.Reasons, rule.reason)
+			}
+		}
+		if !decision.Keep {
+			if inWindow {
+				decision.Reasons = append(decision.Reasons, PruneSuperseded)
+			} else {
+				decision.Reasons = append(decision.Reasons, PruneOutOfRetention)
+			}
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions
+}
+
+// Kept dates of kept snapshots from newest to oldest
+func (ds RetentionDecisions) Kept() []LocalDate {
+	return ds.dates(true)
+}
+
+// Pruned dates of pruned snapshots from newest to oldest
+func (ds RetentionDecisions) Pruned() []LocalDate {
+	return ds.dates(false)
+}
+
+func (ds RetentionDecisions) dates(keep bool) []LocalDate {
+	dates := make([]LocalDate, 0, len(ds))
+	for _, d := range ds {
+		if d.Keep == keep {
+			dates = append(dates, d.Date)
+		}
+	}
+	return dates
+}
+
+// retentionRule period is the start of the period which the snapshot belongs to
+type retentionRule struct {
+	reason RetentionReason
+	period func(LocalDate) LocalDate
+	oldest LocalDate
+}
+
+func (p RetentionPolicy) rules(today LocalDate) []retentionRule {
+	week := UnitWeekStartingOn(p.WeekStart)
+	rules := make([]retentionRule, 0, 4)
+	if p.Daily > 0 {
+		rules = append(rules, retentionRule{
+			reason: RetainDaily,
+			period: func(d LocalDate) LocalDate { return d },
+			oldest: addDays(today, 1-p.Daily),
+		})
+	}
+	if p.Weekly > 0 {
+		rules = append(rules, retentionRule{
+			reason: RetainWeekly,
+			period: func(d LocalDate) LocalDate { return d.Truncate(week) },
+			oldest: addDays(today.Truncate(week), (1-p.Weekly)*DaysOfWeek),
+		})
+	}
+	if p.Monthly > 0 {
+		rules = append(rules, retentionRule{
+			reason: RetainMonthly,
+			period: func(d LocalDate) LocalDate { return d.Truncate(UnitMonth) },
+			oldest: YearMonthFromDate(today).MinusMonths(p.Monthly - 1).Period().Start,
+		})
+	}
+	if p.Yearly > 0 {
+		rules = append(rules, retentionRule{
+			reason: RetainYearly,
+			period: func(d LocalDate) LocalDate { return d.Truncate(UnitYear) },
+			oldest: YearMonth{Year: today.Year, Month: MinMonthOfYear}.PlusYears(1 - p.Yearly).Period().Start,
+		})
+	}
+	return rules
+}
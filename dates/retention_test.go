@@ -0,0 +1,58 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestRetentionPolicy_Evaluate(t *testing.T) {
+	names := []string{
+		"20241010", "20241011", "20241012", "20241013", "20241014", "20241015", "20241016", "20241016",
+		"20241017", "20241001", "20240930", "20240915", "20240831", "20231231", "20220630",
+	}
+	snapshots := make([]LocalDate, 0, len(names))
+	for _, name := range names {
+		d, err := ParseLocalDate(Date, name)
+		Nil(t, err)
+		snapshots = append(snapshots, d)
+	}
+	today := NewLocalDate(2024, 10, 16)
+	policy := RetentionPolicy{Daily: 3, Weekly: 2, Monthly: 3, Yearly: 2, WeekStart: time.Monday}
+
+	decisions := policy.Evaluate(snapshots, today)
+	Equal(t, RetentionDecisions{
+		{Date: NewLocalDate(2024, 10, 17), Keep: true, Reasons: []RetentionReason{RetainFuture}},
+		{Date: NewLocalDate(2024, 10, 16), Keep: true, Reasons: []RetentionReason{RetainDaily, RetainWeekly, RetainMonthly, RetainYearly}},
+		{Date: NewLocalDate(2024, 10, 16), Keep: false, Reasons: []RetentionReason{PruneDuplicate}},
+		{Date: NewLocalDate(2024, 10, 15), Keep: true, Reasons: []RetentionReason{RetainDaily}},
+		{Date: NewLocalDate(2024, 10, 14), Keep: true, Reasons: []RetentionReason{RetainDaily}},
+		{Date: NewLocalDate(2024, 10, 13), Keep: true, Reasons: []RetentionReason{RetainWeekly}},
+		{Date: NewLocalDate(2024, 10, 12), Keep: false, Reasons: []RetentionReason{PruneSuperseded}},
+		{Date: NewLocalDate(2024, 10, 11), Keep: false, Reasons: []RetentionReason{PruneSuperseded}},
+		{Date: NewLocalDate(2024, 10, 10), Keep: false, Reasons: []RetentionReason{PruneSuperseded}},
+		{Date: NewLocalDate(2024, 10, 1), Keep: false, Reasons: []RetentionReason{PruneSuperseded}},
+		{Date: NewLocalDate(2024, 9, 30), Keep: true, Reasons: []RetentionReason{RetainMonthly}},
+		{Date: NewLocalDate(2024, 9, 15), Keep: false, Reasons: []RetentionReason{PruneSuperseded}},
+		{Date: NewLocalDate(2024, 8, 31), Keep: true, Reasons: []RetentionReason{RetainMonthly}},
+		{Date: NewLocalDate(2023, 12, 31), Keep: true, Reasons: []RetentionReason{RetainYearly}},
+		{Date: NewLocalDate(2022, 6, 30), Keep: false, Reasons: []RetentionReason{PruneOutOfRetention}},
+	}, decisions)
+	Len(t, decisions.Kept(), 8)
+	Len(t, decisions.Pruned(), 7)
+	Equal(t, NewLocalDate(2024, 10, 10), snapshots[0], "入力は変更されない")
+
+	{
+		policy := RetentionPolicy{Weekly: 2, WeekStart: time.Sunday}
+		Equal(t, []LocalDate{
+			NewLocalDate(2024, 10, 16),
+			NewLocalDate(2024, 10, 12),
+		}, policy.Evaluate(snapshots[:7], today).Kept(), "日曜始まりの場合10/13は今週に含まれる")
+	}
+	{
+		decisions := RetentionPolicy{}.Evaluate(snapshots[:2], today)
+		Equal(t, []LocalDate{NewLocalDate(2024, 10, 11), NewLocalDate(2024, 10, 10)}, decisions.Pruned(), "保持数が全て0の場合全て削除")
+		Equal(t, "out of retention", decisions[0].Reasons[0].String())
+	}
+}
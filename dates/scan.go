@@ -0,0 +1,108 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// IntLocalDate localDate stored as an integer yyyyMMdd. (20241016)
+// LocalDate.Scan reads integers as unix seconds, so use this for integer columns of yyyyMMdd.
+//
+//	integer, string, []byte    yyyyMMdd. 0 is the zero localDate, as 0000-00-00 of MySQL.
+type IntLocalDate struct {
+	LocalDate LocalDate
+}
+
+// Value for go-sql-driver. yyyyMMdd as int64, and the zero localDate is 0.
+func (d IntLocalDate) Value() (driver.Value, error) {
+	if d.LocalDate.IsZero() {
+		return int64(0), nil
+	}
+	date, err := d.LocalDate.Valid()
+	if err != nil {
+		return nil, err
+	}
+	return yyyyMMdd(date), nil
+}
+
+func yyyyMMdd(d LocalDate) int64 {
+	return int64(d.Year)*10000 + int64(d.Month)*100 + int64(d.Day)
+}
+
+// Scan for go-sql-driver. impossible dates are errors.
+func (d *IntLocalDate) Scan(value interface{}) error {
+	if d == nil || value == nil {
+		return &ScanError{Type: "intLocalDate", Value: value, Err: errNilValue}
+	}
+	var v int64
+	var err error
+	switch sv := value.(type) {
+	case string:
+		v, err = strconv.ParseInt(sv, 10, 64)
+	case []byte:
+		v, err = strconv.ParseInt(string(sv), 10, 64)
+	default:
+		cv, ce := driver.DefaultParameterConverter.ConvertValue(value)
+		i, ok := cv.(int64)
+		if ce != nil || !ok {
+			return &ScanError{Type: "intLocalDate", Value: value, Err: errUnsupportedType}
+		}
+		v = i
+	}
+	if err != nil {
+		return newScanError("intLocalDate", value, err)
+	}
+	if v < 0 {
+		return newScanError("intLocalDate", value, &RangeError{Type: "intLocalDate", Field: "yyyyMMdd", Value: v, Min: 0, Max: math.MaxInt64})
+	}
+	date, err := strictLocalDate(LocalDate{Year: uint(v / 10000), Month: uint(v / 100 % 100), Day: uint(v % 100)})
+	if err != nil {
+		return newScanError("intLocalDate", value, err)
+	}
+	d.LocalDate = date
+	return nil
+}
+
+// scanNumeric converts time.Time, integers and floats to LocalDatetime by the table of Scan.
+// ok is false when value is none of them.
+func scanNumeric(typ string, value any) (dt LocalDatetime, ok bool, err error) {
+	switch value.(type) {
+	case string, []byte, nil:
+		return LocalDatetime{}, false, nil
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Uint || rv.Kind() == reflect.Uint64 || rv.Kind() == reflect.Uintptr {
+		// driver.DefaultParameterConverter does not convert them above MaxInt64
+		if rv.Uint() > math.MaxInt64 {
+			return LocalDatetime{}, true, &RangeError{Type: typ, Field: "unix", Min: math.MinInt64, Max: math.MaxInt64}
+		}
+	}
+	cv, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return LocalDatetime{}, false, nil
+	}
+	var tm time.Time
+	switch v := cv.(type) {
+	case time.Time:
+		tm = v
+	case int64:
+		tm = time.Unix(v, 0).In(time.UTC)
+	case float64:
+		// floored, not truncated toward zero. (-1.5 is 23:59:58 of the day before the epoch)
+		if math.IsNaN(v) || math.IsInf(v, 0) || v < math.MinInt64 || math.MaxInt64 <= v {
+			return LocalDatetime{}, true, &RangeError{Type: typ, Field: "unix", Min: math.MinInt64, Max: math.MaxInt64}
+		}
+		tm = time.Unix(int64(math.Floor(v)), 0).In(time.UTC)
+	default:
+		return LocalDatetime{}, false, nil
+	}
+	if y := int64(tm.Year()); y < int64(MinYear) || int64(MaxYear) < y {
+		return LocalDatetime{}, true, &RangeError{Type: typ, Field: "year", Value: y, Min: int64(MinYear), Max: int64(MaxYear)}
+	}
+	return LocalDatetime{
+		LocalDate: LocalDate{Year: uint(tm.Year()), Month: uint(tm.Month()), Day: uint(tm.Day())},
+		LocalTime: LocalTime{Hour: uint(tm.Hour()), Minute: uint(tm.Minute()), Second: uint(tm.Second())},
+	}, true, nil
+}
@@ -0,0 +1,127 @@
+package dates
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+type stringer struct{}
+
+func (stringer) String() string { return "2024-10-16 09:05:03" }
+
+func TestLocalDatetime_ScanValues(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, table := range []struct {
+		title  string
+		input  interface{}
+		expect LocalDatetime
+		err    bool
+	}{
+		{title: "string", input: "2024-10-16 09:05:03", expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "[]byte", input: []byte("2024-10-16 09:05:03"), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "time.Timeはlocationの時刻", input: time.Date(2024, 10, 16, 9, 5, 3, 999999999, jst), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "YYYYMMDDの範囲の整数もunix秒", input: int64(20241016), expect: NewLocalDatetime(1970, 8, 23, 6, 30, 16)},
+		{title: "int型のunix秒", input: 1729069503, expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "unix秒", input: int64(1729069503), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "uint32のunix秒", input: uint32(1729069503), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "0はunix epoch", input: int64(0), expect: NewLocalDatetime(1970, 1, 1, 0, 0, 0)},
+		{title: "負のunix秒", input: int64(-1), expect: NewLocalDatetime(1969, 12, 31, 23, 59, 59)},
+		{title: "floatのunix秒は切り捨て", input: 1729069503.9, expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "負のfloatは床関数", input: -1.5, expect: NewLocalDatetime(1969, 12, 31, 23, 59, 58)},
+		{title: "紀元前", input: FirstUnixInAD - 800*SecondsOfDay, err: true},
+		{title: "NaN", input: math.NaN(), err: true},
+		{title: "Stringer", input: stringer{}, expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "bool", input: true, err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var dt LocalDatetime
+			err := dt.Scan(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrScan))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, dt)
+		})
+	}
+}
+
+func TestLocalDatetime_ScanUint64(t *testing.T) {
+	var dt LocalDatetime
+	err := dt.Scan(uint64(math.MaxInt64) + 1)
+	True(t, errors.Is(err, ErrScan))
+	True(t, errors.Is(err, ErrOutOfRangeDate), "MaxInt64を超えるuint64は範囲外")
+	var d LocalDate
+	True(t, errors.Is(d.Scan(uint64(math.MaxUint64)), ErrOutOfRangeDate))
+}
+
+func TestLocalDate_ScanValues(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, table := range []struct {
+		title  string
+		input  interface{}
+		expect LocalDate
+		err    bool
+	}{
+		{title: "time.Timeはlocationの日付", input: time.Date(2024, 10, 16, 0, 30, 0, 0, jst), expect: NewLocalDate(2024, 10, 16)},
+		{title: "YYYYMMDDの範囲の整数もunix秒", input: int64(20241016), expect: NewLocalDate(1970, 8, 23)},
+		{title: "紀元前", input: FirstUnixInAD - 800*SecondsOfDay, err: true},
+		{title: "unix秒はUTC", input: int64(1729035000), expect: NewLocalDate(2024, 10, 15)},
+		{title: "float32", input: float32(0), expect: NewLocalDate(1970, 1, 1)},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var d LocalDate
+			err := d.Scan(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrOutOfRangeDate))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, d)
+		})
+	}
+}
+
+func TestIntLocalDate(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  interface{}
+		expect LocalDate
+		err    bool
+	}{
+		{title: "int64", input: int64(20241016), expect: NewLocalDate(2024, 10, 16)},
+		{title: "int", input: 20241016, expect: NewLocalDate(2024, 10, 16)},
+		{title: "MySQLのtext protocolの[]byte", input: []byte("20241016"), expect: NewLocalDate(2024, 10, 16)},
+		{title: "string", input: "10000101", expect: NewLocalDate(1000, 1, 1)},
+		{title: "0はzero value", input: int64(0), expect: LocalDate{}},
+		{title: "存在しない日付", input: int64(20230229), err: true},
+		{title: "範囲外の月", input: int64(20241316), err: true},
+		{title: "負の値", input: int64(-20241016), err: true},
+		{title: "数字でない", input: "2024-10-16", err: true},
+		{title: "float", input: 20241016.0, err: true},
+		{title: "nil", input: nil, err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var d IntLocalDate
+			err := d.Scan(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrScan))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, d.LocalDate)
+		})
+	}
+	v, err := IntLocalDate{LocalDate: NewLocalDate(2024, 10, 16)}.Value()
+	Nil(t, err)
+	Equal(t, int64(20241016), v)
+	v, err = IntLocalDate{}.Value()
+	Nil(t, err)
+	Equal(t, int64(0), v, "zero valueは0")
+	_, err = IntLocalDate{LocalDate: LocalDate{Year: 2023, Month: 2, Day: 29}}.Value()
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+}
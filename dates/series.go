@@ -0,0 +1,168 @@
+package dates
+
+import "sort"
+
+// FillStrategy strategy to fill missing values of a series
+type FillStrategy int
+
+// fill strategy enums
+const (
+	// FillZero missing values are filled with zero
+	FillZero FillStrategy = iota
+	// FillPrevious missing values are filled with the previous known value. zero when there is no previous value.
+	FillPrevious
+	// FillLinear missing values are linearly interpolated between the previous and next known values.
+	// the previous value is used when there is no next value, and zero when there is no previous value.
+	// for integer values, the interpolated value is truncated.
+	FillLinear
+)
+
+// Number value type of series
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Point a value keyed by LocalDate or LocalDatetime
+type Point[K Temporal, V Number] struct {
+	Key   K
+	Value V
+}
+
+// Series points ordered by key
+type Series[K Temporal, V Number] []Point[K, V]
+
+// Aggregator aggregates values of a bucket into a value
+type Aggregator[V Number] func(values []V) V
+
+// FillSeries returns a dense series of the range keys filled by the strategy.
+// Known values outside of the range are also used for FillPrevious and FillLinear.
+func FillSeries[K Temporal, V Number](values map[K]V, r Range[K], strategy FillStrategy) Series[K, V] {
+	known := make(Series[K, V], 0, len(values))
+	for k, v := range values {
+		known = append(known, Point[K, V]{Key: k, Value: v})
+	}
+	sortSeries(known)
+
+	series := make(Series[K, V], 0)
+	r.ForEach(func(k K) bool {
+		series = append(series, Point[K, V]{Key: k, Value: fillValue(known, k, strategy)})
+		return true
+	})
+	return series
+}
+
+// FillSeriesFromPoints FillSeries from points. when keys are duplicated, the later point wins.
+func FillSeriesFromPoints[K Temporal, V Number](points []Point[K, V], r Range[K], strategy FillStrategy) Series[K, V] {
+	values := make(map[K]V, len(points))
+	for _, p := range points {
+		values[p.Key] = p.Value
+	}
+	return FillSeries(values, r, strategy)
+}
+
+// Resample downsamples points into buckets of the unit (e.g. UnitWeek, UnitMonth) by the aggregator.
+// The key of each bucket is the start of the unit, and buckets are ordered by key.
+// Empty buckets are not returned. use FillSeries for a dense series.
+func Resample[K Temporal, V Number](points []Point[K, V], unit Unit, aggregate Aggregator[V]) Series[K, V] {
+	buckets := make(map[K][]V)
+	for _, p := range points {
+		key := truncate(p.Key, unit)
+		buckets[key] = append(buckets[key], p.Value)
+	}
+	series := make(Series[K, V], 0, len(buckets))
+	for k, vs := range buckets {
+		series = append(series, Point[K, V]{Key: k, Value: aggregate(vs)})
+	}
+	sortSeries(series)
+	return series
+}
+
+// Map converts the series to a map
+func (s Series[K, V]) Map() map[K]V {
+	values := make(map[K]V, len(s))
+	for _, p := range s {
+		values[p.Key] = p.Value
+	}
+	return values
+}
+
+// AggSum Aggregator of sum
+func AggSum[V Number](values []V) V {
+	var sum V
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// AggMean Aggregator of mean. for integer values, the mean is truncated.
+func AggMean[V Number](values []V) V {
+	if len(values) == 0 {
+		return 0
+	}
+	return V(float64(AggSum(values)) / float64(len(values)))
+}
+
+// AggMin Aggregator of minimum
+func AggMin[V Number](values []V) V {
+	var m V
+	for i, v := range values {
+		if i == 0 || v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// AggMax Aggregator of maximum
+func AggMax[V Number](values []V) V {
+	var m V
+	for i, v := range values {
+		if i == 0 || v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// AggCount Aggregator of the number of values
+func AggCount[V Number](values []V) V {
+	return V(len(values))
+}
+
+func fillValue[K Temporal, V Number](known Series[K, V], k K, strategy FillStrategy) V {
+	pos := position(k)
+	// index of the first known point after k
+	i := sort.Search(len(known), func(i int) bool { return position(known[i].Key) > pos })
+	if i > 0 && position(known[i-1].Key) == pos {
+		return known[i-1].Value
+	}
+	if strategy == FillZero || i == 0 {
+		return 0
+	}
+	prev := known[i-1]
+	if strategy == FillPrevious || i == len(known) {
+		return prev.Value
+	}
+	next := known[i]
+	prevPos, nextPos := position(prev.Key), position(next.Key)
+	ratio := float64(pos-prevPos) / float64(nextPos-prevPos)
+	return V(float64(prev.Value) + (float64(next.Value)-float64(prev.Value))*ratio)
+}
+
+func sortSeries[K Temporal, V Number](s Series[K, V]) {
+	sort.Slice(s, func(i, j int) bool { return position(s[i].Key) < position(s[j].Key) })
+}
+
+func truncate[K Temporal](k K, unit Unit) K {
+	var result K
+	switch t := any(k).(type) {
+	case LocalDate:
+		result = any(t.Truncate(unit)).(K)
+	case LocalDatetime:
+		result = any(t.Truncate(unit)).(K)
+	}
+	return result
+}
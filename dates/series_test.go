@@ -0,0 +1,78 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestFillSeries(t *testing.T) {
+	values := map[LocalDate]float64{
+		NewLocalDate(2024, 9, 30): 5,
+		NewLocalDate(2024, 10, 2): 10,
+		NewLocalDate(2024, 10, 5): 40,
+	}
+	r, err := RangeDates(NewLocalDate(2024, 10, 1), NewLocalDate(2024, 10, 6), StepDays(1))
+	Nil(t, err)
+	for _, table := range []struct {
+		title    string
+		strategy FillStrategy
+		expect   []float64
+	}{
+		{title: "zero", strategy: FillZero, expect: []float64{0, 10, 0, 0, 40, 0}},
+		{title: "previous.範囲外の値も使われる", strategy: FillPrevious, expect: []float64{5, 10, 10, 10, 40, 40}},
+		{title: "linear.末尾はpreviousで埋める", strategy: FillLinear, expect: []float64{7.5, 10, 20, 30, 40, 40}},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			series := FillSeries(values, r, table.strategy)
+			Len(t, series, len(table.expect))
+			for i, p := range series {
+				Equal(t, addDays(NewLocalDate(2024, 10, 1), i), p.Key)
+				Equal(t, table.expect[i], p.Value)
+			}
+		})
+	}
+}
+
+func TestFillSeriesFromPoints(t *testing.T) {
+	points := []Point[LocalDatetime, int]{
+		{Key: NewLocalDatetime(2024, 10, 16, 10, 0, 0), Value: 3},
+		{Key: NewLocalDatetime(2024, 10, 16, 13, 0, 0), Value: 10},
+	}
+	r, err := RangeDatetimes(NewLocalDatetime(2024, 10, 16, 9, 0, 0), NewLocalDatetime(2024, 10, 16, 13, 0, 0), StepDuration(time.Hour))
+	Nil(t, err)
+	series := FillSeriesFromPoints(points, r.Exclusive(), FillLinear)
+	Equal(t, Series[LocalDatetime, int]{
+		{Key: NewLocalDatetime(2024, 10, 16, 9, 0, 0), Value: 0},
+		{Key: NewLocalDatetime(2024, 10, 16, 10, 0, 0), Value: 3},
+		{Key: NewLocalDatetime(2024, 10, 16, 11, 0, 0), Value: 5},
+		{Key: NewLocalDatetime(2024, 10, 16, 12, 0, 0), Value: 7},
+	}, series, "整数の補間値は切り捨て")
+	Equal(t, 3, series.Map()[NewLocalDatetime(2024, 10, 16, 10, 0, 0)])
+}
+
+func TestResample(t *testing.T) {
+	points := []Point[LocalDate, int]{
+		{Key: NewLocalDate(2024, 10, 31), Value: 4},
+		{Key: NewLocalDate(2024, 10, 1), Value: 1},
+		{Key: NewLocalDate(2024, 11, 3), Value: 7},
+		{Key: NewLocalDate(2024, 10, 15), Value: 2},
+	}
+	Equal(t, Series[LocalDate, int]{
+		{Key: NewLocalDate(2024, 10, 1), Value: 7},
+		{Key: NewLocalDate(2024, 11, 1), Value: 7},
+	}, Resample(points, UnitMonth, AggSum[int]), "月単位の合計")
+	Equal(t, Series[LocalDate, int]{
+		{Key: NewLocalDate(2024, 9, 30), Value: 1},
+		{Key: NewLocalDate(2024, 10, 14), Value: 2},
+		{Key: NewLocalDate(2024, 10, 28), Value: 7},
+	}, Resample(points, UnitWeek, AggMax[int]), "週単位の最大値")
+	Equal(t, Series[LocalDate, int]{
+		{Key: NewLocalDate(2024, 1, 1), Value: 3},
+	}, Resample(points, UnitYear, func(values []int) int { return AggCount(values) - 1 }), "任意の集計関数")
+
+	Equal(t, 2, AggMean([]int{1, 2, 4}))
+	Equal(t, 1, AggMin([]int{3, 1, 2}))
+	Equal(t, 0, AggMean([]int{}))
+}
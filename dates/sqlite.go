@@ -0,0 +1,206 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"math"
+	"time"
+)
+
+// SQLiteStorage representation of dates in SQLite, which has no date type.
+// see https://www.sqlite.org/lang_datefunc.html
+type SQLiteStorage int
+
+// sqlite storage enums
+const (
+	// SQLiteText ISO 8601 TEXT. yyyy-MM-dd or yyyy-MM-dd HH:mm:ss (default)
+	SQLiteText SQLiteStorage = iota
+	// SQLiteJulianDay REAL julian day number. as julianday() of SQLite
+	SQLiteJulianDay
+	// SQLiteUnixTime INTEGER unix seconds. as unixepoch() of SQLite
+	SQLiteUnixTime
+)
+
+var _SQLiteStorageNameMap = map[SQLiteStorage]string{
+	SQLiteText:      "TEXT",
+	SQLiteJulianDay: "REAL",
+	SQLiteUnixTime:  "INTEGER",
+}
+
+// JulianDayOfUnixEpoch julian day number of 1970-01-01 00:00:00 UTC
+const JulianDayOfUnixEpoch = 2440587.5
+
+// String to string. type affinity of SQLite
+func (s SQLiteStorage) String() string {
+	return _SQLiteStorageNameMap[s]
+}
+
+// SQLiteLocalDate localDate stored in SQLite.
+// Value encodes by Storage, and Scan decodes all the storages regardless of Storage.
+//
+//	string, []byte    yyyy-MM-dd (time is ignored)
+//	float             julian day number
+//	integer           unix seconds
+//	time.Time         the date of the wall clock. (mattn/go-sqlite3 converts DATE columns to time.Time)
+type SQLiteLocalDate struct {
+	LocalDate LocalDate
+	Storage   SQLiteStorage
+}
+
+// SQLiteLocalDatetime localDatetime stored in SQLite.
+// Value encodes by Storage, and Scan decodes all the storages regardless of Storage.
+//
+//	string, []byte    yyyy-MM-dd HH:mm:ss, yyyy-MM-ddTHH:mm:ss, yyyy-MM-dd HH:mm or yyyy-MM-dd (fraction and zone are ignored)
+//	float             julian day number. rounded to seconds
+//	integer           unix seconds
+//	time.Time         the wall clock. (mattn/go-sqlite3 converts DATETIME columns to time.Time)
+type SQLiteLocalDatetime struct {
+	LocalDatetime LocalDatetime
+	Storage       SQLiteStorage
+}
+
+// Value for go-sql-driver. julian day and unix time require a valid date.
+func (d SQLiteLocalDate) Value() (driver.Value, error) {
+	if d.Storage == SQLiteText {
+		return d.LocalDate.Value()
+	}
+	return SQLiteLocalDatetime{LocalDatetime: LocalDatetime{LocalDate: d.LocalDate}, Storage: d.Storage}.Value()
+}
+
+// Scan for go-sql-driver. impossible dates are errors, and 0000-00-00 is the zero localDate.
+func (d *SQLiteLocalDate) Scan(value interface{}) error {
+	if d == nil || value == nil {
+		return &ScanError{Type: "sqliteLocalDate", Value: value, Err: errNilValue}
+	}
+	var date LocalDate
+	var err error
+	switch v := value.(type) {
+	case string:
+		date, err = scanCanonicalDate(v)
+	case []byte:
+		date, err = scanCanonicalDate(v)
+	default:
+		var dt LocalDatetime
+		dt, err = scanSQLiteNumeric("sqliteLocalDate", value)
+		date = dt.LocalDate
+	}
+	if err == nil {
+		date, err = strictLocalDate(date)
+	}
+	if err != nil {
+		return newScanError("sqliteLocalDate", value, err)
+	}
+	d.LocalDate = date
+	return nil
+}
+
+// Value for go-sql-driver. julian day and unix time require a valid datetime.
+func (dt SQLiteLocalDatetime) Value() (driver.Value, error) {
+	switch dt.Storage {
+	case SQLiteJulianDay:
+		if _, err := dt.LocalDatetime.Valid(); err != nil {
+			return nil, err
+		}
+		return float64(dt.LocalDatetime.epochSecond())/SecondsOfDay + JulianDayOfUnixEpoch, nil
+	case SQLiteUnixTime:
+		if _, err := dt.LocalDatetime.Valid(); err != nil {
+			return nil, err
+		}
+		return dt.LocalDatetime.epochSecond(), nil
+	default:
+		return dt.LocalDatetime.Value()
+	}
+}
+
+// Scan for go-sql-driver. impossible datetimes are errors, and 0000-00-00 00:00:00 is the zero localDatetime.
+func (dt *SQLiteLocalDatetime) Scan(value interface{}) error {
+	if dt == nil || value == nil {
+		return &ScanError{Type: "sqliteLocalDatetime", Value: value, Err: errNilValue}
+	}
+	var datetime LocalDatetime
+	var err error
+	switch v := value.(type) {
+	case string:
+		datetime, err = scanSQLiteText(v)
+	case []byte:
+		datetime, err = scanSQLiteText(v)
+	default:
+		datetime, err = scanSQLiteNumeric("sqliteLocalDatetime", value)
+	}
+	if err == nil {
+		datetime, err = strictLocalDatetime(datetime)
+	}
+	if err != nil {
+		return newScanError("sqliteLocalDatetime", value, err)
+	}
+	dt.LocalDatetime = datetime
+	return nil
+}
+
+var sqliteTimeFields = []canonicalField{
+	{name: "hour", minDigits: 2, maxDigits: 2},
+	{name: "minute", sep: ':', minDigits: 2, maxDigits: 2},
+}
+
+// scanSQLiteText parses time-values of SQLite. seconds may be omitted, and date only is midnight.
+func scanSQLiteText[T text](s T) (LocalDatetime, error) {
+	var v [6]canonicalValue
+	i, err := parseFields(s, 0, DateTimeHyphen, lenientDateFields, v[:3])
+	if err != nil || i == len(s) {
+		return LocalDatetime{LocalDate: LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value}}, err
+	}
+	if s[i] != ' ' && s[i] != 'T' {
+		return LocalDatetime{}, &ParseError{Input: string(s), Format: DateTimeHyphen, Offset: i, Err: errUnmatched}
+	}
+	if i, err = parseFields(s, i+1, DateTimeHyphen, sqliteTimeFields, v[3:5]); err != nil {
+		return LocalDatetime{}, err
+	}
+	if i < len(s) && s[i] == ':' {
+		if _, err = parseFields(s, i+1, DateTimeHyphen, sqliteTimeFields[:1], v[5:]); err != nil {
+			return LocalDatetime{}, err
+		}
+	}
+	return LocalDatetime{
+		LocalDate: LocalDate{Year: v[0].value, Month: v[1].value, Day: v[2].value},
+		LocalTime: LocalTime{Hour: v[3].value, Minute: v[4].value, Second: v[5].value},
+	}, nil
+}
+
+// scanSQLiteNumeric converts julian day, unix seconds and time.Time. impossible dates are not checked.
+func scanSQLiteNumeric(typ string, value any) (LocalDatetime, error) {
+	if tm, ok := value.(time.Time); ok {
+		return LocalDatetime{
+			LocalDate: LocalDate{Year: uint(tm.Year()), Month: uint(tm.Month()), Day: uint(tm.Day())},
+			LocalTime: LocalTime{Hour: uint(tm.Hour()), Minute: uint(tm.Minute()), Second: uint(tm.Second())},
+		}, sqliteYearError(typ, int64(tm.Year()))
+	}
+	cv, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return LocalDatetime{}, errUnsupportedType
+	}
+	var epochSecond int64
+	switch v := cv.(type) {
+	case int64:
+		epochSecond = v
+	case float64:
+		seconds := math.Round((v - JulianDayOfUnixEpoch) * SecondsOfDay)
+		if math.IsNaN(seconds) || seconds < math.MinInt64 || math.MaxInt64 <= seconds {
+			return LocalDatetime{}, &RangeError{Type: typ, Field: "julianDay", Min: math.MinInt64, Max: math.MaxInt64}
+		}
+		epochSecond = int64(seconds)
+	default:
+		return LocalDatetime{}, errUnsupportedType
+	}
+	dt := localDatetimeOfEpochSecond(epochSecond)
+	if dt.IsZero() {
+		maxEpochSecond := LocalDatetime{LocalDate: LocalDate{Year: MaxYear, Month: 12, Day: 31}, LocalTime: LocalTime{Hour: 23, Minute: 59, Second: 59}}.epochSecond()
+		return LocalDatetime{}, &RangeError{Type: typ, Field: "unix", Value: epochSecond, Min: FirstUnixInAD, Max: maxEpochSecond}
+	}
+	return dt, nil
+}
+
+func sqliteYearError(typ string, year int64) error {
+	if year < int64(MinYear) || int64(MaxYear) < year {
+		return &RangeError{Type: typ, Field: "year", Value: year, Min: int64(MinYear), Max: int64(MaxYear)}
+	}
+	return nil
+}
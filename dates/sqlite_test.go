@@ -0,0 +1,109 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteLocalDatetime_Value(t *testing.T) {
+	dt := NewLocalDatetime(2024, 10, 16, 9, 5, 3)
+	for _, table := range []struct {
+		storage SQLiteStorage
+		expect  interface{}
+	}{
+		{storage: SQLiteText, expect: "2024-10-16 09:05:03"},
+		{storage: SQLiteJulianDay, expect: 2460599.5 + float64(9*SecondsOfHour+5*SecondsOfMinute+3)/SecondsOfDay},
+		{storage: SQLiteUnixTime, expect: int64(1729069503)},
+	} {
+		t.Run(table.storage.String(), func(t *testing.T) {
+			actual, err := SQLiteLocalDatetime{LocalDatetime: dt, Storage: table.storage}.Value()
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+
+			var scanned SQLiteLocalDatetime
+			Nil(t, scanned.Scan(actual))
+			Equal(t, dt, scanned.LocalDatetime, "どの形式でもScanできる")
+		})
+	}
+	_, err := SQLiteLocalDatetime{Storage: SQLiteUnixTime}.Value()
+	True(t, errors.Is(err, ErrOutOfRangeDate), "数値は有効な日時のみ")
+}
+
+func TestSQLiteLocalDate_Value(t *testing.T) {
+	d := NewLocalDate(2024, 10, 16)
+	for _, table := range []struct {
+		storage SQLiteStorage
+		expect  interface{}
+	}{
+		{storage: SQLiteText, expect: "2024-10-16"},
+		{storage: SQLiteJulianDay, expect: 2460599.5},
+		{storage: SQLiteUnixTime, expect: int64(1729036800)},
+	} {
+		t.Run(table.storage.String(), func(t *testing.T) {
+			actual, err := SQLiteLocalDate{LocalDate: d, Storage: table.storage}.Value()
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+
+			var scanned SQLiteLocalDate
+			Nil(t, scanned.Scan(actual))
+			Equal(t, d, scanned.LocalDate)
+		})
+	}
+	_, err := SQLiteLocalDate{LocalDate: LocalDate{Year: 2023, Month: 2, Day: 29}, Storage: SQLiteJulianDay}.Value()
+	True(t, errors.Is(err, ErrOutOfRangeDate))
+}
+
+func TestSQLiteLocalDatetime_Scan(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  interface{}
+		expect LocalDatetime
+		err    bool
+	}{
+		{title: "datetime()", input: "2024-10-16 09:05:03", expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "Tで区切る", input: "2024-10-16T09:05:03", expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "小数とzoneは無視", input: []byte("2024-10-16T09:05:03.123+09:00"), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "秒なし", input: "2024-10-16 09:05", expect: NewLocalDatetime(2024, 10, 16, 9, 5, 0)},
+		{title: "日付のみ", input: "2024-10-16", expect: NewLocalDatetime(2024, 10, 16, 0, 0, 0)},
+		{title: "区切り文字が異なる", input: "2024-10-16_09:05:03", err: true},
+		{title: "存在しない日", input: "2023-02-29 00:00:00", err: true},
+		{title: "julianday()の誤差は丸める", input: 2460599.878506944, expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "unixepoch()", input: int64(1729069503), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "YYYYMMDDもunix秒", input: int64(20241016), expect: NewLocalDatetime(1970, 8, 23, 6, 30, 16)},
+		{title: "time.Time", input: time.Date(2024, 10, 16, 9, 5, 3, 0, time.FixedZone("JST", 9*60*60)), expect: NewLocalDatetime(2024, 10, 16, 9, 5, 3)},
+		{title: "紀元前のjulian day", input: 0.0, err: true},
+		{title: "bool", input: true, err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			var dt SQLiteLocalDatetime
+			err := dt.Scan(table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrScan))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, dt.LocalDatetime)
+		})
+	}
+	{
+		dt := SQLiteLocalDatetime{Storage: SQLiteJulianDay}
+		Nil(t, dt.Scan(int64(0)))
+		Equal(t, SQLiteJulianDay, dt.Storage, "Storageは変わらない")
+		Equal(t, NewLocalDatetime(1970, 1, 1, 0, 0, 0), dt.LocalDatetime)
+	}
+}
+
+func TestSQLiteLocalDate_Scan(t *testing.T) {
+	var d SQLiteLocalDate
+	Nil(t, d.Scan("2024-10-16 09:05:03"))
+	Equal(t, NewLocalDate(2024, 10, 16), d.LocalDate, "時刻は無視")
+	Nil(t, d.Scan(2460599.878506944))
+	Equal(t, NewLocalDate(2024, 10, 16), d.LocalDate)
+	Nil(t, d.Scan(int64(1729069503)))
+	Equal(t, NewLocalDate(2024, 10, 16), d.LocalDate)
+	True(t, errors.Is(d.Scan(nil), ErrScan))
+	True(t, errors.Is(d.Scan("2024-13-01"), ErrOutOfRangeDate))
+}
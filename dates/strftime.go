@@ -0,0 +1,399 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedDirective strftime directive is not supported
+var ErrUnsupportedDirective = errors.New("unsupported directive")
+
+// _StrftimeCompositeMap directives which are shorthand for other directives
+var _StrftimeCompositeMap = map[byte]string{
+	'F': "%Y-%m-%d",
+	'T': "%H:%M:%S",
+	'D': "%m/%d/%y",
+	'R': "%H:%M",
+	'r': "%I:%M:%S %p",
+}
+
+// _StrftimeWidthMap default width of numeric directives
+var _StrftimeWidthMap = map[byte]int{
+	'Y': 4, 'C': 2, 'y': 2, 'G': 4, 'g': 2,
+	'm': 2, 'd': 2, 'e': 2,
+	'H': 2, 'I': 2, 'k': 2, 'l': 2, 'M': 2, 'S': 2,
+	'j': 3, 'U': 2, 'W': 2, 'V': 2, 'u': 1, 'w': 1,
+}
+
+// _StrftimeCanonicalMap directives which are parsed into the same field
+var _StrftimeCanonicalMap = map[byte]byte{
+	'e': 'd', 'k': 'H', 'l': 'I', 'a': 'w', 'A': 'w', 'b': 'm', 'B': 'm', 'h': 'm',
+}
+
+type strftimeToken struct {
+	verb    byte
+	flag    byte
+	literal string
+}
+
+// Strftime formats the localDate by strftime directives. time directives are formatted as midnight.
+//
+//	%Y year            %C century          %y year of century (69-99 is 19xx, 00-68 is 20xx when parsing)
+//	%G ISO 8601 year   %g ISO year of century
+//	%m month           %d day              %e day padded with space
+//	%H hour (00-23)    %I hour (01-12)     %k hour padded with space  %l hour (1-12) padded with space
+//	%M minute          %S second           %p AM or PM
+//	%j day of year     %U week of year (Sunday first)  %W week of year (Monday first)  %V ISO 8601 week
+//	%u day of week (1-7, Monday is 1)      %w day of week (0-6, Sunday is 0)
+//	%a %A weekday name %b %h %B month name
+//	%F %Y-%m-%d        %T %H:%M:%S         %D %m/%d/%y   %R %H:%M   %r %I:%M:%S %p
+//	%n newline         %t tab              %% percent
+//
+// GNU flags "-" (no padding), "_" (pad with space) and "0" (pad with zero) are supported for numeric directives.
+// Names are english, as the POSIX locale.
+// Directives depending on time zones or locales (%z %Z %s %c %x %X, %E and %O modifiers) are not supported.
+func (d LocalDate) Strftime(format string) (string, error) {
+	tokens, err := compileStrftime(format)
+	if err != nil {
+		return "", err
+	}
+	return strftime(tokens, d, LocalTime{}), nil
+}
+
+// Strftime formats the localDatetime by strftime directives.
+func (dt LocalDatetime) Strftime(format string) (string, error) {
+	tokens, err := compileStrftime(format)
+	if err != nil {
+		return "", err
+	}
+	return strftime(tokens, dt.LocalDate, dt.LocalTime), nil
+}
+
+// StrptimeLocalDate parses localDate by strftime directives. time directives are parsed, but ignored.
+// The year is required. month and day are 1 when they are not in the format, as python.
+func StrptimeLocalDate(format, t string) (LocalDate, error) {
+	dt, err := StrptimeLocalDatetime(format, t)
+	if err != nil {
+		return LocalDate{}, err
+	}
+	return dt.LocalDate, nil
+}
+
+// StrptimeLocalDatetime parses localDatetime by strftime directives.
+// The year is required. the date can also be specified by %j, %G %V %u or %U/%W with the weekday.
+// Names are case-insensitive, and conflicting fields are errors.
+func StrptimeLocalDatetime(format, t string) (LocalDatetime, error) {
+	tokens, err := compileStrftime(format)
+	if err != nil {
+		return LocalDatetime{}, err
+	}
+	values := make(map[byte]int)
+	pos := 0
+	for _, token := range tokens {
+		if token.verb == 0 {
+			if !strings.HasPrefix(t[pos:], token.literal) {
+				return LocalDatetime{}, strptimeError(format, t, pos, fmt.Sprintf("expected %q", token.literal))
+			}
+			pos += len(token.literal)
+			continue
+		}
+		var value, n int
+		switch token.verb {
+		case 'a', 'A':
+			value, n = matchNameFold(t[pos:], 0, 6, func(i int) []string { return []string{LocaleEn.weekdayName(i, true), LocaleEn.weekdayName(i, false)} })
+		case 'b', 'B', 'h':
+			value, n = matchNameFold(t[pos:], 1, 12, func(i int) []string { return []string{LocaleEn.monthName(i, true), LocaleEn.monthName(i, false)} })
+		case 'p':
+			value, n = matchNameFold(t[pos:], 0, 1, func(i int) []string { return []string{LocaleEn.AmPm[i]} })
+		default:
+			if token.verb == 'e' || token.verb == 'k' || token.verb == 'l' || token.flag == '_' {
+				for pos < len(t) && t[pos] == ' ' {
+					pos++
+				}
+			}
+			value, n = matchDigits(t[pos:], 1, _StrftimeWidthMap[token.verb])
+		}
+		if n == 0 {
+			return LocalDatetime{}, strptimeError(format, t, pos, fmt.Sprintf("expected %%%c", token.verb))
+		}
+		key := token.verb
+		if canonical, ok := _StrftimeCanonicalMap[key]; ok {
+			key = canonical
+		}
+		if prev, ok := values[key]; ok && prev != value {
+			return LocalDatetime{}, strptimeError(format, t, pos, fmt.Sprintf("conflicting %%%c", token.verb))
+		}
+		values[key] = value
+		pos += n
+	}
+	if pos != len(t) {
+		return LocalDatetime{}, strptimeError(format, t, pos, "extra text")
+	}
+	dt, err := strptimeResolve(values)
+	if err != nil {
+		return LocalDatetime{}, fmt.Errorf("%w: %q by %q: %v", ErrParse, t, format, err)
+	}
+	return dt, nil
+}
+
+func compileStrftime(format string) ([]strftimeToken, error) {
+	tokens := make([]strftimeToken, 0)
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			tokens = appendStrftimeLiteral(tokens, format[i:i+1])
+			continue
+		}
+		start := i
+		i++
+		var flag byte
+		if i < len(format) && (format[i] == '-' || format[i] == '_' || format[i] == '0') {
+			flag = format[i]
+			i++
+		}
+		if i >= len(format) {
+			return nil, fmt.Errorf("%w: incomplete directive at %d in %q", ErrUnsupportedDirective, start, format)
+		}
+		verb := format[i]
+		switch {
+		case verb == '%':
+			tokens = appendStrftimeLiteral(tokens, "%")
+		case verb == 'n':
+			tokens = appendStrftimeLiteral(tokens, "\n")
+		case verb == 't':
+			tokens = appendStrftimeLiteral(tokens, "\t")
+		case _StrftimeCompositeMap[verb] != "":
+			composite, _ := compileStrftime(_StrftimeCompositeMap[verb])
+			for _, token := range composite {
+				if token.verb == 0 {
+					tokens = appendStrftimeLiteral(tokens, token.literal)
+				} else {
+					tokens = append(tokens, token)
+				}
+			}
+		case _StrftimeWidthMap[verb] != 0:
+			tokens = append(tokens, strftimeToken{verb: verb, flag: flag})
+		case strings.IndexByte("aAbBhp", verb) >= 0 && flag == 0:
+			tokens = append(tokens, strftimeToken{verb: verb})
+		default:
+			return nil, fmt.Errorf("%w: %q at %d in %q", ErrUnsupportedDirective, format[start:i+1], start, format)
+		}
+	}
+	return tokens, nil
+}
+
+func appendStrftimeLiteral(tokens []strftimeToken, literal string) []strftimeToken {
+	if last := len(tokens) - 1; last >= 0 && tokens[last].verb == 0 {
+		tokens[last].literal += literal
+		return tokens
+	}
+	return append(tokens, strftimeToken{literal: literal})
+}
+
+func strftime(tokens []strftimeToken, d LocalDate, t LocalTime) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		switch token.verb {
+		case 0:
+			b.WriteString(token.literal)
+		case 'a', 'A':
+			if !d.IsZero() {
+				b.WriteString(LocaleEn.weekdayName(int(d.Weekday()), token.verb == 'A'))
+			}
+		case 'b', 'B', 'h':
+			if d.Month != 0 {
+				b.WriteString(LocaleEn.monthName(int(d.Month), token.verb == 'B'))
+			}
+		case 'p':
+			b.WriteString(LocaleEn.amPm(t.Hour))
+		default:
+			key := token.verb
+			if canonical, ok := _StrftimeCanonicalMap[key]; ok {
+				key = canonical
+			}
+			s := strconv.Itoa(strftimeValue(key, d, t))
+			width := _StrftimeWidthMap[token.verb]
+			pad := "0"
+			if token.verb == 'e' || token.verb == 'k' || token.verb == 'l' {
+				pad = " "
+			}
+			switch token.flag {
+			case '-':
+				width = 0
+			case '_':
+				pad = " "
+			case '0':
+				pad = "0"
+			}
+			if len(s) < width {
+				s = strings.Repeat(pad, width-len(s)) + s
+			}
+			b.WriteString(s)
+		}
+	}
+	return b.String()
+}
+
+// strftimeValue numeric value of the canonical directive
+func strftimeValue(verb byte, d LocalDate, t LocalTime) int {
+	switch verb {
+	case 'Y':
+		return int(d.Year)
+	case 'C':
+		return int(d.Year / 100)
+	case 'y':
+		return int(d.Year % 100)
+	case 'm':
+		return int(d.Month)
+	case 'd':
+		return int(d.Day)
+	case 'H':
+		return int(t.Hour)
+	case 'I':
+		return hourOfAmPm(t.Hour)
+	case 'M':
+		return int(t.Minute)
+	case 'S':
+		return int(t.Second)
+	case 'p':
+		if t.Hour < 12 {
+			return 0
+		}
+		return 1
+	}
+	if d.IsZero() {
+		return 0
+	}
+	switch verb {
+	case 'G', 'g', 'V':
+		year, week := d.ToTimeUtc().ISOWeek()
+		switch verb {
+		case 'G':
+			return year
+		case 'g':
+			return year % 100
+		}
+		return week
+	case 'j':
+		return d.DayOfYear()
+	case 'U':
+		return (d.DayOfYear() + 6 - int(d.Weekday())) / DaysOfWeek
+	case 'W':
+		return (d.DayOfYear() + 6 - (int(d.Weekday())+6)%DaysOfWeek) / DaysOfWeek
+	case 'u':
+		return (int(d.Weekday())+6)%DaysOfWeek + 1
+	case 'w':
+		return int(d.Weekday())
+	}
+	return 0
+}
+
+func strptimeResolve(values map[byte]int) (LocalDatetime, error) {
+	has := func(verb byte) bool {
+		_, ok := values[verb]
+		return ok
+	}
+	weekday, ok := values['w']
+	if u, okU := values['u']; okU && !ok {
+		weekday, ok = u%DaysOfWeek, true
+	}
+
+	var d LocalDate
+	switch {
+	case has('G') && has('V') && !has('Y'):
+		jan4 := NewLocalDate(values['G'], 1, 4)
+		if jan4.IsZero() {
+			return LocalDatetime{}, fmt.Errorf("year %d is out of range", values['G'])
+		}
+		monday := addDays(jan4, -((int(jan4.Weekday()) + 6) % DaysOfWeek))
+		if !ok {
+			weekday = int(time.Monday)
+		}
+		d = addDays(monday, (values['V']-1)*DaysOfWeek+(weekday+6)%DaysOfWeek)
+	default:
+		year, okY := values['Y']
+		switch {
+		case okY:
+		case has('C'):
+			year = values['C']*100 + values['y']
+		case has('y'):
+			// POSIX: 69-99 is 1969-1999, 00-68 is 2000-2068
+			year = values['y'] + 2000
+			if values['y'] >= 69 {
+				year -= 100
+			}
+		default:
+			return LocalDatetime{}, errors.New("year is required")
+		}
+		jan1 := NewLocalDate(year, 1, 1)
+		if year < 1 || jan1.IsZero() {
+			return LocalDatetime{}, fmt.Errorf("year %d is out of range", year)
+		}
+		switch {
+		case has('m') || has('d'):
+			month, day := 1, 1
+			if has('m') {
+				month = values['m']
+			}
+			if has('d') {
+				day = values['d']
+			}
+			d = NewLocalDate(year, month, day)
+			if d.Month != uint(month) || d.Day != uint(day) {
+				return LocalDatetime{}, fmt.Errorf("%d-%d-%d is out of range", year, month, day)
+			}
+		case has('j'):
+			d = addDays(jan1, values['j']-1)
+		case has('U') && ok:
+			firstSunday := (DaysOfWeek - int(jan1.Weekday())) % DaysOfWeek
+			d = addDays(jan1, firstSunday+(values['U']-1)*DaysOfWeek+weekday)
+		case has('W') && ok:
+			firstMonday := (DaysOfWeek + 1 - int(jan1.Weekday())) % DaysOfWeek
+			d = addDays(jan1, firstMonday+(values['W']-1)*DaysOfWeek+(weekday+6)%DaysOfWeek)
+		default:
+			d = jan1
+		}
+	}
+
+	var t LocalTime
+	switch {
+	case has('I'):
+		if values['I'] < 1 || 12 < values['I'] {
+			return LocalDatetime{}, fmt.Errorf("hour %d is out of range of %%I", values['I'])
+		}
+		t.Hour = uint(values['I']%12 + values['p']*12)
+	case has('H'):
+		t.Hour = uint(values['H'])
+	}
+	t.Minute, t.Second = uint(values['M']), uint(values['S'])
+	if t.Hour > MaxHourOfDay || t.Minute > MaxMinuteOfHour || t.Second > MaxSecOfMinute {
+		return LocalDatetime{}, fmt.Errorf("%02d:%02d:%02d is out of range", t.Hour, t.Minute, t.Second)
+	}
+
+	// 指定された全てのfieldが, 解決した日時と一致することを確認する
+	for verb, value := range values {
+		if actual := strftimeValue(verb, d, t); actual != value {
+			return LocalDatetime{}, fmt.Errorf("%%%c is %d, but %d for %v %v", verb, value, actual, d, t)
+		}
+	}
+	return LocalDatetime{LocalDate: d, LocalTime: t}, nil
+}
+
+func strptimeError(format, t string, pos int, reason string) error {
+	return &ParseError{Input: t, Format: Format(format), Offset: pos, Err: errors.New(reason)}
+}
+
+// matchNameFold returns the value and the length of the longest name of min..max at the head of s, case-insensitively
+func matchNameFold(s string, min, max int, names func(int) []string) (int, int) {
+	value, n := 0, 0
+	for i := min; i <= max; i++ {
+		for _, name := range names(i) {
+			if len(name) > n && len(name) <= len(s) && strings.EqualFold(s[:len(name)], name) {
+				value, n = i, len(name)
+			}
+		}
+	}
+	return value, n
+}
@@ -0,0 +1,89 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDatetime_Strftime(t *testing.T) {
+	dt := NewLocalDatetime(2024, 10, 6, 9, 5, 3)
+	for _, table := range []struct {
+		format string
+		expect string
+	}{
+		{format: "%Y-%m-%d %H:%M:%S", expect: "2024-10-06 09:05:03"},
+		{format: "%F %T", expect: "2024-10-06 09:05:03"},
+		{format: "%D %R", expect: "10/06/24 09:05"},
+		{format: "%r", expect: "09:05:03 AM"},
+		{format: "%a %A %b %B %h", expect: "Sun Sunday Oct October Oct"},
+		{format: "%j %U %W %V %G %u %w", expect: "280 40 40 40 2024 7 0"},
+		{format: "%C %y %g", expect: "20 24 24"},
+		{format: "[%e] [%k] [%l]", expect: "[ 6] [ 9] [ 9]"},
+		{format: "%-m/%-d %-H:%M", expect: "10/6 9:05"},
+		{format: "[%_d] [%0e]", expect: "[ 6] [06]"},
+		{format: "100%%%n%t", expect: "100%\n\t"},
+	} {
+		t.Run(table.format, func(t *testing.T) {
+			actual, err := dt.Strftime(table.format)
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		actual, err := NewLocalDate(2021, 1, 1).Strftime("%G-W%V-%u %U %W %j")
+		Nil(t, err)
+		Equal(t, "2020-W53-5 00 00 001", actual, "ISO年は前年")
+	}
+	for _, format := range []string{"%Y %z", "%Z", "%s", "%c", "%Ey", "%Od", "%-a", "%Y %"} {
+		_, err := dt.Strftime(format)
+		True(t, errors.Is(err, ErrUnsupportedDirective), format)
+	}
+}
+
+func TestStrptimeLocalDatetime(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		format string
+		input  string
+		expect LocalDatetime
+		err    bool
+	}{
+		{title: "日時", format: "%Y-%m-%d %H:%M:%S", input: "2024-10-06 09:05:03", expect: NewLocalDatetime(2024, 10, 6, 9, 5, 3)},
+		{title: "ゼロ埋めなしも許容", format: "%Y/%m/%d %H:%M", input: "2024/1/6 9:05", expect: NewLocalDatetime(2024, 1, 6, 9, 5, 0)},
+		{title: "名前は大文字小文字を区別しない", format: "%a, %d %b %Y", input: "SUN, 06 october 2024", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "12時間表記", format: "%F %I:%M %p", input: "2024-10-06 12:30 AM", expect: NewLocalDatetime(2024, 10, 6, 0, 30, 0)},
+		{title: "通算日", format: "%Y-%j", input: "2024-280", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "ISO週", format: "%G-W%V-%u", input: "2020-W53-5", expect: NewLocalDatetime(2021, 1, 1, 0, 0, 0)},
+		{title: "日曜始まりの週", format: "%Y %U %w", input: "2024 40 0", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "月曜始まりの週", format: "%Y %W %a", input: "2024 40 Sun", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "2桁年は69以降が1900年代", format: "%y%m%d", input: "690101", expect: NewLocalDatetime(1969, 1, 1, 0, 0, 0)},
+		{title: "2桁年は68以前が2000年代", format: "%y%m%d", input: "680101", expect: NewLocalDatetime(2068, 1, 1, 0, 0, 0)},
+		{title: "スペース埋め", format: "%b %e %Y", input: "Oct  6 2024", expect: NewLocalDatetime(2024, 10, 6, 0, 0, 0)},
+		{title: "月日がない場合1月1日", format: "%Y", input: "2024", expect: NewLocalDatetime(2024, 1, 1, 0, 0, 0)},
+		{title: "年がない", format: "%m-%d", input: "10-06", err: true},
+		{title: "曜日が一致しない", format: "%a %F", input: "Mon 2024-10-06", err: true},
+		{title: "存在しない日付", format: "%F", input: "2023-02-29", err: true},
+		{title: "午前午後が一致しない", format: "%H %p %F", input: "13 AM 2024-10-06", err: true},
+		{title: "%Iは1-12", format: "%F %I", input: "2024-10-06 00", err: true},
+		{title: "余分な文字", format: "%F", input: "2024-10-06 ", err: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			actual, err := StrptimeLocalDatetime(table.format, table.input)
+			if table.err {
+				True(t, errors.Is(err, ErrParse))
+				return
+			}
+			Nil(t, err)
+			Equal(t, table.expect, actual)
+		})
+	}
+	{
+		d, err := StrptimeLocalDate("%d.%m.%Y", "06.10.2024")
+		Nil(t, err)
+		Equal(t, NewLocalDate(2024, 10, 6), d)
+		_, err = StrptimeLocalDate("%Y %Q", "2024 1")
+		True(t, errors.Is(err, ErrUnsupportedDirective))
+	}
+}
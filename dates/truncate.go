@@ -0,0 +1,201 @@
+package dates
+
+import "time"
+
+// time unit const
+const (
+	SecondsOfMinute = 60
+	SecondsOfHour   = 60 * SecondsOfMinute
+	SecondsOfDay    = 24 * SecondsOfHour
+)
+
+type unitKind int
+
+const (
+	unitSecond unitKind = iota
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitQuarter
+	unitYear
+)
+
+var _UnitKindNameMap = map[unitKind]string{
+	unitSecond:  "second",
+	unitMinute:  "minute",
+	unitHour:    "hour",
+	unitDay:     "day",
+	unitWeek:    "week",
+	unitMonth:   "month",
+	unitQuarter: "quarter",
+	unitYear:    "year",
+}
+
+// Unit calendar unit for Truncate, Round, StartOf and EndOf
+type Unit struct {
+	kind      unitKind
+	weekStart time.Weekday
+}
+
+// unit list
+var (
+	UnitSecond  = Unit{kind: unitSecond}
+	UnitMinute  = Unit{kind: unitMinute}
+	UnitHour    = Unit{kind: unitHour}
+	UnitDay     = Unit{kind: unitDay}
+	UnitWeek    = Unit{kind: unitWeek, weekStart: time.Monday} // ISO 8601
+	UnitMonth   = Unit{kind: unitMonth}
+	UnitQuarter = Unit{kind: unitQuarter}
+	UnitYear    = Unit{kind: unitYear}
+)
+
+// UnitWeekStartingOn week unit starting on the weekday
+func UnitWeekStartingOn(weekStart time.Weekday) Unit {
+	return Unit{kind: unitWeek, weekStart: weekStart}
+}
+
+// String to string
+func (u Unit) String() string {
+	if u.kind == unitWeek {
+		return _UnitKindNameMap[u.kind] + "(" + u.weekStart.String() + ")"
+	}
+	return _UnitKindNameMap[u.kind]
+}
+
+// Truncate returns the first day of the unit which the localDate belongs to.
+// Units smaller than a day are treated as UnitDay.
+// UnitQuarter returns the localDate unchanged when the month is zero or invalid.
+func (d LocalDate) Truncate(unit Unit) LocalDate {
+	switch unit.kind {
+	case unitWeek:
+		return PreviousOrSame(unit.weekStart).Adjust(d)
+	case unitMonth:
+		return FirstDayOfMonth().Adjust(d)
+	case unitQuarter:
+		if d.Month < MinMonthOfYear || MaxMonthOfYear < d.Month {
+			return d
+		}
+		return LocalDate{Year: d.Year, Month: uint(d.Quarter()-1)*MonthsOfQuarter + 1, Day: MinDayOfMonth}
+	case unitYear:
+		return FirstDayOfYear().Adjust(d)
+	default:
+		return d
+	}
+}
+
+// StartOf alias of Truncate
+func (d LocalDate) StartOf(unit Unit) LocalDate {
+	return d.Truncate(unit)
+}
+
+// EndOf returns the last day of the unit which the localDate belongs to. (inclusive)
+func (d LocalDate) EndOf(unit Unit) LocalDate {
+	return addDays(d.EndOfExclusive(unit), -1)
+}
+
+// EndOfExclusive returns the first day of the next unit. (exclusive end)
+//
+//	[d.StartOf(unit), d.EndOfExclusive(unit))
+func (d LocalDate) EndOfExclusive(unit Unit) LocalDate {
+	start := d.Truncate(unit)
+	switch unit.kind {
+	case unitWeek:
+		return addDays(start, DaysOfWeek)
+	case unitMonth:
+		return YearMonthFromDate(start).PlusMonths(1).Period().Start
+	case unitQuarter:
+		return YearMonthFromDate(start).PlusMonths(int(MonthsOfQuarter)).Period().Start
+	case unitYear:
+		return YearMonthFromDate(start).PlusYears(1).Period().Start
+	default:
+		return addDays(start, 1)
+	}
+}
+
+// Round returns the start of the unit nearest to the localDate. the midpoint is rounded up.
+func (d LocalDate) Round(unit Unit) LocalDate {
+	start, next := d.Truncate(unit), d.EndOfExclusive(unit)
+	if daysBetween(start, d)*2 >= daysBetween(start, next) {
+		return next
+	}
+	return start
+}
+
+// Truncate returns the start of the unit which the localDatetime belongs to.
+func (dt LocalDatetime) Truncate(unit Unit) LocalDatetime {
+	switch unit.kind {
+	case unitSecond:
+		return dt
+	case unitMinute:
+		return LocalDatetime{LocalDate: dt.LocalDate, LocalTime: LocalTime{Hour: dt.LocalTime.Hour, Minute: dt.LocalTime.Minute}}
+	case unitHour:
+		return LocalDatetime{LocalDate: dt.LocalDate, LocalTime: LocalTime{Hour: dt.LocalTime.Hour}}
+	default:
+		return dt.LocalDate.Truncate(unit).LocalDatetime()
+	}
+}
+
+// StartOf alias of Truncate
+func (dt LocalDatetime) StartOf(unit Unit) LocalDatetime {
+	return dt.Truncate(unit)
+}
+
+// EndOf returns the last second of the unit which the localDatetime belongs to. (inclusive)
+//
+//	2024-10-16 13:14:15, UnitDay ---> 2024-10-16 23:59:59
+func (dt LocalDatetime) EndOf(unit Unit) LocalDatetime {
+	return dt.EndOfExclusive(unit).plusSeconds(-1)
+}
+
+// EndOfExclusive returns the start of the next unit. (exclusive end)
+//
+//	2024-10-16 13:14:15, UnitDay ---> 2024-10-17 00:00:00
+func (dt LocalDatetime) EndOfExclusive(unit Unit) LocalDatetime {
+	start := dt.Truncate(unit)
+	switch unit.kind {
+	case unitSecond:
+		return start.plusSeconds(1)
+	case unitMinute:
+		return start.plusSeconds(SecondsOfMinute)
+	case unitHour:
+		return start.plusSeconds(SecondsOfHour)
+	default:
+		return dt.LocalDate.EndOfExclusive(unit).LocalDatetime()
+	}
+}
+
+// Round returns the start of the unit nearest to the localDatetime. the midpoint is rounded up.
+func (dt LocalDatetime) Round(unit Unit) LocalDatetime {
+	start, next := dt.Truncate(unit), dt.EndOfExclusive(unit)
+	if (dt.epochSecond()-start.epochSecond())*2 >= next.epochSecond()-start.epochSecond() {
+		return next
+	}
+	return start
+}
+
+func (dt LocalDatetime) epochSecond() int64 {
+	t := dt.LocalTime
+	return dt.LocalDate.EpochDay()*SecondsOfDay + int64(t.Hour*SecondsOfHour+t.Minute*SecondsOfMinute+t.Second)
+}
+
+func (dt LocalDatetime) plusSeconds(seconds int64) LocalDatetime {
+	return localDatetimeOfEpochSecond(dt.epochSecond() + seconds)
+}
+
+func localDatetimeOfEpochSecond(epochSecond int64) LocalDatetime {
+	date := LocalDateOfEpochDay(floorDiv(epochSecond, SecondsOfDay))
+	if date.IsZero() {
+		return LocalDatetime{}
+	}
+	secOfDay := uint(floorMod(epochSecond, SecondsOfDay))
+	return LocalDatetime{
+		LocalDate: date,
+		LocalTime: LocalTime{
+			Hour:   secOfDay / SecondsOfHour,
+			Minute: secOfDay % SecondsOfHour / SecondsOfMinute,
+			Second: secOfDay % SecondsOfMinute,
+		},
+	}
+}
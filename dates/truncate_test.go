@@ -0,0 +1,147 @@
+package dates
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDate_Truncate(t *testing.T) {
+	// 2024-08-16 is Friday
+	d := NewLocalDate(2024, 8, 16)
+	for _, table := range []struct {
+		title             string
+		unit              Unit
+		expectStart       LocalDate
+		expectEnd         LocalDate
+		expectEndExcluded LocalDate
+		expectRound       LocalDate
+	}{
+		{
+			title:       "時間単位はdayとして扱う",
+			unit:        UnitHour,
+			expectStart: d, expectEnd: d, expectEndExcluded: NewLocalDate(2024, 8, 17), expectRound: d,
+		},
+		{
+			title:       "day",
+			unit:        UnitDay,
+			expectStart: d, expectEnd: d, expectEndExcluded: NewLocalDate(2024, 8, 17), expectRound: d,
+		},
+		{
+			title:       "week.月曜始まり",
+			unit:        UnitWeek,
+			expectStart: NewLocalDate(2024, 8, 12), expectEnd: NewLocalDate(2024, 8, 18), expectEndExcluded: NewLocalDate(2024, 8, 19), expectRound: NewLocalDate(2024, 8, 19),
+		},
+		{
+			title:       "week.日曜始まり",
+			unit:        UnitWeekStartingOn(time.Sunday),
+			expectStart: NewLocalDate(2024, 8, 11), expectEnd: NewLocalDate(2024, 8, 17), expectEndExcluded: NewLocalDate(2024, 8, 18), expectRound: NewLocalDate(2024, 8, 18),
+		},
+		{
+			title:       "month",
+			unit:        UnitMonth,
+			expectStart: NewLocalDate(2024, 8, 1), expectEnd: NewLocalDate(2024, 8, 31), expectEndExcluded: NewLocalDate(2024, 9, 1), expectRound: NewLocalDate(2024, 8, 1),
+		},
+		{
+			title:       "quarter.中間日は切り上げ",
+			unit:        UnitQuarter,
+			expectStart: NewLocalDate(2024, 7, 1), expectEnd: NewLocalDate(2024, 9, 30), expectEndExcluded: NewLocalDate(2024, 10, 1), expectRound: NewLocalDate(2024, 10, 1),
+		},
+		{
+			title:       "year",
+			unit:        UnitYear,
+			expectStart: NewLocalDate(2024, 1, 1), expectEnd: NewLocalDate(2024, 12, 31), expectEndExcluded: NewLocalDate(2025, 1, 1), expectRound: NewLocalDate(2025, 1, 1),
+		},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			Equal(t, table.expectStart, d.Truncate(table.unit))
+			Equal(t, table.expectStart, d.StartOf(table.unit))
+			Equal(t, table.expectEnd, d.EndOf(table.unit))
+			Equal(t, table.expectEndExcluded, d.EndOfExclusive(table.unit))
+			Equal(t, table.expectRound, d.Round(table.unit))
+		})
+	}
+	Equal(t, NewLocalDate(2024, 9, 1), NewLocalDate(2024, 8, 17).Round(UnitMonth), "31日の月の17日目は切り上げ")
+	Equal(t, LocalDate{}, LocalDate{}.Truncate(UnitQuarter), "zero valueはそのまま")
+	Equal(t, LocalDate{Year: 2024, Month: 13, Day: 1}, LocalDate{Year: 2024, Month: 13, Day: 1}.Truncate(UnitQuarter), "存在しない月はそのまま")
+}
+
+func TestLocalDatetime_Truncate(t *testing.T) {
+	dt := NewLocalDatetime(2024, 10, 16, 13, 44, 30)
+	for _, table := range []struct {
+		title             string
+		unit              Unit
+		expectStart       LocalDatetime
+		expectEnd         LocalDatetime
+		expectEndExcluded LocalDatetime
+		expectRound       LocalDatetime
+	}{
+		{
+			title:             "second",
+			unit:              UnitSecond,
+			expectStart:       dt,
+			expectEnd:         dt,
+			expectEndExcluded: NewLocalDatetime(2024, 10, 16, 13, 44, 31),
+			expectRound:       dt,
+		},
+		{
+			title:             "minute.30秒は切り上げ",
+			unit:              UnitMinute,
+			expectStart:       NewLocalDatetime(2024, 10, 16, 13, 44, 0),
+			expectEnd:         NewLocalDatetime(2024, 10, 16, 13, 44, 59),
+			expectEndExcluded: NewLocalDatetime(2024, 10, 16, 13, 45, 0),
+			expectRound:       NewLocalDatetime(2024, 10, 16, 13, 45, 0),
+		},
+		{
+			title:             "hour",
+			unit:              UnitHour,
+			expectStart:       NewLocalDatetime(2024, 10, 16, 13, 0, 0),
+			expectEnd:         NewLocalDatetime(2024, 10, 16, 13, 59, 59),
+			expectEndExcluded: NewLocalDatetime(2024, 10, 16, 14, 0, 0),
+			expectRound:       NewLocalDatetime(2024, 10, 16, 14, 0, 0),
+		},
+		{
+			title:             "day",
+			unit:              UnitDay,
+			expectStart:       NewLocalDatetime(2024, 10, 16, 0, 0, 0),
+			expectEnd:         NewLocalDatetime(2024, 10, 16, 23, 59, 59),
+			expectEndExcluded: NewLocalDatetime(2024, 10, 17, 0, 0, 0),
+			expectRound:       NewLocalDatetime(2024, 10, 17, 0, 0, 0),
+		},
+		{
+			title:             "week",
+			unit:              UnitWeek,
+			expectStart:       NewLocalDatetime(2024, 10, 14, 0, 0, 0),
+			expectEnd:         NewLocalDatetime(2024, 10, 20, 23, 59, 59),
+			expectEndExcluded: NewLocalDatetime(2024, 10, 21, 0, 0, 0),
+			expectRound:       NewLocalDatetime(2024, 10, 14, 0, 0, 0),
+		},
+		{
+			title:             "month",
+			unit:              UnitMonth,
+			expectStart:       NewLocalDatetime(2024, 10, 1, 0, 0, 0),
+			expectEnd:         NewLocalDatetime(2024, 10, 31, 23, 59, 59),
+			expectEndExcluded: NewLocalDatetime(2024, 11, 1, 0, 0, 0),
+			expectRound:       NewLocalDatetime(2024, 11, 1, 0, 0, 0),
+		},
+		{
+			title:             "year",
+			unit:              UnitYear,
+			expectStart:       NewLocalDatetime(2024, 1, 1, 0, 0, 0),
+			expectEnd:         NewLocalDatetime(2024, 12, 31, 23, 59, 59),
+			expectEndExcluded: NewLocalDatetime(2025, 1, 1, 0, 0, 0),
+			expectRound:       NewLocalDatetime(2025, 1, 1, 0, 0, 0),
+		},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			Equal(t, table.expectStart, dt.Truncate(table.unit))
+			Equal(t, table.expectStart, dt.StartOf(table.unit))
+			Equal(t, table.expectEnd, dt.EndOf(table.unit))
+			Equal(t, table.expectEndExcluded, dt.EndOfExclusive(table.unit))
+			Equal(t, table.expectRound, dt.Round(table.unit))
+		})
+	}
+	Equal(t, "week(Sunday)", UnitWeekStartingOn(time.Sunday).String())
+	Equal(t, "quarter", UnitQuarter.String())
+}
@@ -0,0 +1,137 @@
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ErrIncorrectWindow window size, slide or gap is incorrect
+var ErrIncorrectWindow = errors.New("window size, slide or gap must be a positive multiple of 1s")
+
+// TumblingWindow fixed size, non-overlapping windows aligned to origin.
+// Windows are half-open [Start, End), and do not depend on time zones. create by NewTumblingWindow.
+type TumblingWindow struct {
+	size   time.Duration
+	origin LocalDatetime
+}
+
+// NewTumblingWindow new tumblingWindow
+func NewTumblingWindow(size time.Duration, origin LocalDatetime) (TumblingWindow, error) {
+	if err := validWindowDuration(size); err != nil {
+		return TumblingWindow{}, err
+	}
+	return TumblingWindow{size: size, origin: origin}, nil
+}
+
+// Size window size
+func (w TumblingWindow) Size() time.Duration {
+	return w.size
+}
+
+// Origin window origin
+func (w TumblingWindow) Origin() LocalDatetime {
+	return w.origin
+}
+
+// Assign returns the window which the localDatetime belongs to. zero tumblingWindow returns zero period.
+func (w TumblingWindow) Assign(dt LocalDatetime) LocalDatetimePeriod {
+	if w.size <= 0 {
+		return LocalDatetimePeriod{}
+	}
+	return windowAt(w.origin, w.size, w.size, dt, 0)
+}
+
+// HoppingWindow fixed size windows which start every slide, aligned to origin.
+// When slide is smaller than size, windows overlap. Windows are half-open [Start, End). create by NewHoppingWindow.
+type HoppingWindow struct {
+	size   time.Duration
+	slide  time.Duration
+	origin LocalDatetime
+}
+
+// NewHoppingWindow new hoppingWindow
+func NewHoppingWindow(size, slide time.Duration, origin LocalDatetime) (HoppingWindow, error) {
+	if err := validWindowDuration(size); err != nil {
+		return HoppingWindow{}, err
+	}
+	if err := validWindowDuration(slide); err != nil {
+		return HoppingWindow{}, err
+	}
+	return HoppingWindow{size: size, slide: slide, origin: origin}, nil
+}
+
+// Size window size
+func (w HoppingWindow) Size() time.Duration {
+	return w.size
+}
+
+// Slide interval between window starts
+func (w HoppingWindow) Slide() time.Duration {
+	return w.slide
+}
+
+// Origin window origin
+func (w HoppingWindow) Origin() LocalDatetime {
+	return w.origin
+}
+
+// Assign returns all windows which the localDatetime belongs to, ordered by start.
+// When slide is larger than size, the localDatetime may belong to no window. zero hoppingWindow returns no window.
+func (w HoppingWindow) Assign(dt LocalDatetime) LocalDatetimePeriods {
+	if w.size <= 0 || w.slide <= 0 {
+		return LocalDatetimePeriods{}
+	}
+	windows := make(LocalDatetimePeriods, 0, int(w.size/w.slide)+1)
+	// 最後に開始したwindowから遡って, dtを含むwindowを探す
+	for i := int64(0); ; i-- {
+		window := windowAt(w.origin, w.size, w.slide, dt, i)
+		if !window.End.After(dt) {
+			break
+		}
+		if !window.Start.After(dt) {
+			windows = append(windows, window)
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+	return windows
+}
+
+// SessionWindows groups localDatetimes into sessions separated by inactivity of gap or more.
+// Each window is [first event, last event + gap), ordered by start. the input is not modified.
+func SessionWindows(events []LocalDatetime, gap time.Duration) (LocalDatetimePeriods, error) {
+	if err := validWindowDuration(gap); err != nil {
+		return nil, err
+	}
+	sorted := make([]LocalDatetime, len(events))
+	copy(sorted, events)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].epochSecond() < sorted[j].epochSecond() })
+
+	gapSeconds := int64(gap / time.Second)
+	windows := make(LocalDatetimePeriods, 0)
+	for _, event := range sorted {
+		end := event.plusSeconds(gapSeconds)
+		if last := len(windows) - 1; last >= 0 && event.Before(windows[last].End) {
+			windows[last].End = end
+			continue
+		}
+		windows = append(windows, LocalDatetimePeriod{Start: event, End: end})
+	}
+	return windows, nil
+}
+
+// windowAt returns the n-th window from the last window started at or before dt.
+func windowAt(origin LocalDatetime, size, slide time.Duration, dt LocalDatetime, n int64) LocalDatetimePeriod {
+	originSec, slideSec := origin.epochSecond(), int64(slide/time.Second)
+	index := floorDiv(dt.epochSecond()-originSec, slideSec) + n
+	start := localDatetimeOfEpochSecond(originSec + index*slideSec)
+	return LocalDatetimePeriod{Start: start, End: start.plusSeconds(int64(size / time.Second))}
+}
+
+func validWindowDuration(d time.Duration) error {
+	if d <= 0 || d%time.Second != 0 {
+		return fmt.Errorf("%w: %v", ErrIncorrectWindow, d)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestTumblingWindow_Assign(t *testing.T) {
+	origin := NewLocalDatetime(2024, 10, 16, 0, 0, 0)
+	w, err := NewTumblingWindow(15*time.Minute, origin)
+	Nil(t, err)
+	for _, table := range []struct {
+		title  string
+		input  LocalDatetime
+		expect LocalDatetimePeriod
+	}{
+		{
+			title:  "windowの開始時刻はそのwindowに含まれる",
+			input:  NewLocalDatetime(2024, 10, 16, 10, 15, 0),
+			expect: LocalDatetimePeriod{Start: NewLocalDatetime(2024, 10, 16, 10, 15, 0), End: NewLocalDatetime(2024, 10, 16, 10, 30, 0)},
+		},
+		{
+			title:  "windowの終了時刻は次のwindowに含まれる",
+			input:  NewLocalDatetime(2024, 10, 16, 10, 29, 59),
+			expect: LocalDatetimePeriod{Start: NewLocalDatetime(2024, 10, 16, 10, 15, 0), End: NewLocalDatetime(2024, 10, 16, 10, 30, 0)},
+		},
+		{
+			title:  "originより前",
+			input:  NewLocalDatetime(2024, 10, 15, 23, 50, 0),
+			expect: LocalDatetimePeriod{Start: NewLocalDatetime(2024, 10, 15, 23, 45, 0), End: NewLocalDatetime(2024, 10, 16, 0, 0, 0)},
+		},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			Equal(t, table.expect, w.Assign(table.input))
+		})
+	}
+	{
+		w, err := NewTumblingWindow(24*time.Hour, NewLocalDatetime(2024, 1, 1, 9, 0, 0))
+		Nil(t, err)
+		Equal(t, LocalDatetimePeriod{
+			Start: NewLocalDatetime(2024, 10, 15, 9, 0, 0),
+			End:   NewLocalDatetime(2024, 10, 16, 9, 0, 0),
+		}, w.Assign(NewLocalDatetime(2024, 10, 16, 8, 59, 59)), "9時始まりの日次window")
+	}
+	Equal(t, 15*time.Minute, w.Size())
+	Equal(t, origin, w.Origin())
+	_, err = NewTumblingWindow(0, origin)
+	True(t, errors.Is(err, ErrIncorrectWindow))
+	_, err = NewTumblingWindow(1500*time.Millisecond, origin)
+	True(t, errors.Is(err, ErrIncorrectWindow))
+	Equal(t, LocalDatetimePeriod{}, TumblingWindow{}.Assign(origin), "zero valueはpanicせずzero periodを返す")
+}
+
+func TestHoppingWindow_Assign(t *testing.T) {
+	origin := NewLocalDatetime(2024, 10, 16, 0, 0, 0)
+	{
+		w, err := NewHoppingWindow(time.Hour, 20*time.Minute, origin)
+		Nil(t, err)
+		Equal(t, time.Hour, w.Size())
+		Equal(t, 20*time.Minute, w.Slide())
+		Equal(t, origin, w.Origin())
+		Equal(t, LocalDatetimePeriods{
+			{Start: NewLocalDatetime(2024, 10, 16, 9, 20, 0), End: NewLocalDatetime(2024, 10, 16, 10, 20, 0)},
+			{Start: NewLocalDatetime(2024, 10, 16, 9, 40, 0), End: NewLocalDatetime(2024, 10, 16, 10, 40, 0)},
+			{Start: NewLocalDatetime(2024, 10, 16, 10, 0, 0), End: NewLocalDatetime(2024, 10, 16, 11, 0, 0)},
+		}, w.Assign(NewLocalDatetime(2024, 10, 16, 10, 5, 0)))
+	}
+	{
+		w, err := NewHoppingWindow(10*time.Minute, 30*time.Minute, origin)
+		Nil(t, err)
+		Empty(t, w.Assign(NewLocalDatetime(2024, 10, 16, 10, 15, 0)), "slideがsizeより大きい場合, どのwindowにも属さないことがある")
+		Len(t, w.Assign(NewLocalDatetime(2024, 10, 16, 10, 35, 0)), 1)
+	}
+	_, err := NewHoppingWindow(time.Hour, -time.Minute, origin)
+	True(t, errors.Is(err, ErrIncorrectWindow))
+	Empty(t, HoppingWindow{}.Assign(origin), "zero valueはpanicせずwindowなし")
+}
+
+func TestSessionWindows(t *testing.T) {
+	events := []LocalDatetime{
+		NewLocalDatetime(2024, 10, 16, 10, 20, 0),
+		NewLocalDatetime(2024, 10, 16, 10, 0, 0),
+		NewLocalDatetime(2024, 10, 16, 10, 9, 59),
+		NewLocalDatetime(2024, 10, 16, 10, 30, 0),
+		NewLocalDatetime(2024, 10, 16, 11, 0, 0),
+	}
+	windows, err := SessionWindows(events, 10*time.Minute)
+	Nil(t, err)
+	Equal(t, LocalDatetimePeriods{
+		{Start: NewLocalDatetime(2024, 10, 16, 10, 0, 0), End: NewLocalDatetime(2024, 10, 16, 10, 19, 59)},
+		{Start: NewLocalDatetime(2024, 10, 16, 10, 20, 0), End: NewLocalDatetime(2024, 10, 16, 10, 30, 0)},
+		{Start: NewLocalDatetime(2024, 10, 16, 10, 30, 0), End: NewLocalDatetime(2024, 10, 16, 10, 40, 0)},
+		{Start: NewLocalDatetime(2024, 10, 16, 11, 0, 0), End: NewLocalDatetime(2024, 10, 16, 11, 10, 0)},
+	}, windows, "gap以上の間隔でsessionが分かれる. 間隔がちょうどgapの場合も分かれる")
+	Equal(t, NewLocalDatetime(2024, 10, 16, 10, 20, 0), events[0], "入力は変更されない")
+
+	windows, err = SessionWindows(nil, time.Minute)
+	Nil(t, err)
+	Empty(t, windows)
+	_, err = SessionWindows(events, 0)
+	True(t, errors.Is(err, ErrIncorrectWindow))
+}
@@ -0,0 +1,246 @@
+package dates
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// YearMonth represents a month of a year without a timezone
+type YearMonth struct {
+	Year  uint
+	Month uint
+}
+
+// Valid valid yearMonth
+func (ym YearMonth) Valid() (YearMonth, error) {
+	if ym.Year < MinYear || MaxYear < ym.Year {
+		return ym, newRangeError("yearMonth", "year", ym.Year, MinYear, MaxYear)
+	}
+	if ym.Month < MinMonthOfYear || MaxMonthOfYear < ym.Month {
+		return ym, newRangeError("yearMonth", "month", ym.Month, MinMonthOfYear, MaxMonthOfYear)
+	}
+	return ym, nil
+}
+
+// Value for go-sql-driver. format: yyyy-MM
+func (ym YearMonth) Value() (driver.Value, error) {
+	year, month := ym.SplitString()
+	return year + "-" + month, nil
+}
+
+// Scan for go-sql-driver. yyyy-MM and yyyyMM are accepted, and dates such as yyyy-MM-dd are errors.
+// impossible months are errors, and 0000-00 is the zero yearMonth. use LenientYearMonth to normalize impossible months.
+func (ym *YearMonth) Scan(value interface{}) error {
+	if ym == nil || value == nil {
+		return &ScanError{Type: "yearMonth", Value: value, Err: errNilValue}
+	}
+	yearMonth, err := scanYearMonth("yearMonth", value, strictYearMonth)
+	if err != nil {
+		return err
+	}
+	*ym = yearMonth
+	return nil
+}
+
+// scanYearMonth converts the value as YearMonth.Scan, and resolves impossible months
+func scanYearMonth(typ string, value any, resolve func(YearMonth) (YearMonth, error)) (YearMonth, error) {
+	var yearMonth YearMonth
+	var err error
+	switch v := value.(type) {
+	case string:
+		yearMonth, err = scanCanonicalYearMonth(v)
+	case []byte:
+		yearMonth, err = scanCanonicalYearMonth(v)
+	default:
+		sv, ce := driver.String.ConvertValue(value)
+		str, ok := sv.(string)
+		if ce != nil || !ok {
+			return YearMonth{}, &ScanError{Type: typ, Value: value, Err: errUnsupportedType}
+		}
+		yearMonth, err = scanCanonicalYearMonth(str)
+	}
+	if err == nil {
+		yearMonth, err = resolve(yearMonth)
+	}
+	if err != nil {
+		return YearMonth{}, newScanError(typ, value, err)
+	}
+	return yearMonth, nil
+}
+
+// String to string. format: yyyy-MM
+func (ym YearMonth) String() string {
+	val, _ := ym.Value()
+	return val.(string)
+}
+
+// SplitString returns year and month with the same number of digits.
+//
+//	(year,month)= (2000,1) ---> "2000", "01"
+func (ym YearMonth) SplitString() (yearStr, monthStr string) {
+	year, month, _ := LocalDate{Year: ym.Year, Month: ym.Month}.SplitString()
+	return year, month
+}
+
+// IsZero yearMonth is zero?
+func (ym YearMonth) IsZero() bool {
+	return ym.Year == 0 && ym.Month == 0
+}
+
+// IsLeapYear reports whether the year of yearMonth is a leap year.
+func (ym YearMonth) IsLeapYear() bool {
+	return isLeapYear(ym.Year)
+}
+
+// LengthOfMonth returns the number of days in the month.
+func (ym YearMonth) LengthOfMonth() int {
+	return int(daysInMonth(ym.Year, ym.Month))
+}
+
+// AtDay returns the localDate of the specified day in this month.
+// An error is returned when the day does not exist in the month.
+func (ym YearMonth) AtDay(day int) (LocalDate, error) {
+	if _, err := ym.Valid(); err != nil {
+		return LocalDate{}, err
+	}
+	if day < int(MinDayOfMonth) || ym.LengthOfMonth() < day {
+		return LocalDate{}, fmt.Errorf("%w: yearMonth %s has no day %d", ErrOutOfRangeDate, ym, day)
+	}
+	return LocalDate{Year: ym.Year, Month: ym.Month, Day: uint(day)}, nil
+}
+
+// AtEndOfMonth returns the last localDate of the month.
+// A zero or invalid yearMonth returns the zero localDate.
+func (ym YearMonth) AtEndOfMonth() LocalDate {
+	if _, err := ym.Valid(); err != nil {
+		return LocalDate{}
+	}
+	return LocalDate{Year: ym.Year, Month: ym.Month, Day: uint(ym.LengthOfMonth())}
+}
+
+// Period returns the period from the first day to the last day of the month.(both inclusive)
+// A zero or invalid yearMonth returns the zero period.
+func (ym YearMonth) Period() LocalDatePeriod {
+	if _, err := ym.Valid(); err != nil {
+		return LocalDatePeriod{}
+	}
+	return LocalDatePeriod{
+		Start: LocalDate{Year: ym.Year, Month: ym.Month, Day: MinDayOfMonth},
+		End:   ym.AtEndOfMonth(),
+	}
+}
+
+// PlusMonths yearMonth add months.
+// If the result is in BC, it will return empty.
+func (ym YearMonth) PlusMonths(months int) YearMonth {
+	return NewYearMonth(int(ym.Year), int(ym.Month)+months)
+}
+
+// MinusMonths yearMonth subtract months.
+// If the result is in BC, it will return empty.
+func (ym YearMonth) MinusMonths(months int) YearMonth {
+	return ym.PlusMonths(-months)
+}
+
+// PlusYears yearMonth add years.
+func (ym YearMonth) PlusYears(years int) YearMonth {
+	return ym.PlusMonths(years * int(MaxMonthOfYear))
+}
+
+// Before reports whether the yearMonth is before target.
+func (ym YearMonth) Before(target YearMonth) bool {
+	return ym.Year < target.Year || (ym.Year == target.Year && ym.Month < target.Month)
+}
+
+// After reports whether the yearMonth is after target.
+func (ym YearMonth) After(target YearMonth) bool {
+	return target.Before(ym)
+}
+
+// Equal yearMonth Equal?
+func (ym YearMonth) Equal(target YearMonth) bool {
+	return ym.Year == target.Year && ym.Month == target.Month
+}
+
+// MarshalJSON for json return format: yyyy-MM
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	if ym.IsZero() {
+		return MarshalJSON(nil)
+	}
+	return MarshalJSON(ym.String())
+}
+
+// UnmarshalJSON for json default format yyyy-MM
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if ym == nil || len(data) == 0 {
+		return fmt.Errorf("%w: yearMonth, receiver is nil or data len is 0", ErrUnmarshalJSON)
+	}
+	var yearMonth YearMonth
+	var err error
+	if str, ok := jsonString(data); ok {
+		yearMonth, err = parseCanonicalYearMonth(str)
+	} else {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("%w: failed to unmarshal yearMonth, err: %w", ErrUnmarshalJSON, err)
+		}
+		yearMonth, err = parseCanonicalYearMonth(str)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse yearMonth, err: %w", ErrUnmarshalJSON, err)
+	}
+	*ym = yearMonth
+	return nil
+}
+
+// UnmarshalFlag for flag default format yyyy-MM
+func (ym *YearMonth) UnmarshalFlag(s string) error {
+	if ym == nil || len(s) == 0 {
+		return fmt.Errorf("%w: yearMonth. receiver is nil or data len is 0", ErrUnmarshalFlag)
+	}
+	yearMonth, err := parseCanonicalYearMonth(s)
+	if err != nil {
+		return fmt.Errorf("%w: yearMonth, err: %w", ErrUnmarshalFlag, err)
+	}
+	*ym = yearMonth
+	return nil
+}
+
+// NewYearMonth new yearMonth
+// If a number exceeding the maximum value is given, such as month: 13, a calendar calculation is performed and initialized.
+// If the result of the calendar calculation is in BC, it will return empty.
+func NewYearMonth(year, month int) YearMonth {
+	months := year*int(MaxMonthOfYear) + month - 1
+	if months < int(MaxMonthOfYear) {
+		return YearMonth{}
+	}
+	return YearMonth{Year: uint(months / int(MaxMonthOfYear)), Month: uint(months%int(MaxMonthOfYear)) + 1}
+}
+
+// YearMonthFromDate localDate to yearMonth
+func YearMonthFromDate(d LocalDate) YearMonth {
+	return YearMonth{Year: d.Year, Month: d.Month}
+}
+
+// YearMonthFromTime converts from time to yearMonth. (tz is ignored)
+func YearMonthFromTime(tm time.Time) YearMonth {
+	return YearMonth{Year: uint(tm.Year()), Month: uint(tm.Month())}
+}
+
+// ParseYearMonth parse yearMonth by string
+func ParseYearMonth(f Format, t string) (YearMonth, error) {
+	loc := UTC.Location() //yearMonthのため、このtimezoneは使用しない
+
+	tm, err := time.ParseInLocation(f.String(), t, loc)
+	if err != nil {
+		return YearMonth{}, newParseError(f, t, err)
+	}
+	return YearMonthFromTime(tm), nil
+}
+
+// YearMonth localDate to yearMonth
+func (d LocalDate) YearMonth() YearMonth {
+	return YearMonthFromDate(d)
+}
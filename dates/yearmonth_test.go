@@ -0,0 +1,155 @@
+package dates
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+type YearMonthStruct struct {
+	YearMonth YearMonth `json:"year_month"`
+}
+
+func TestNewYearMonth(t *testing.T) {
+	Equal(t, YearMonth{Year: 2020, Month: 4}, NewYearMonth(2020, 4))
+	Equal(t, YearMonth{Year: 2021, Month: 2}, NewYearMonth(2020, 14), "12を超える月を指定した場合, 計算後の結果が算出される")
+	Equal(t, YearMonth{Year: 2019, Month: 12}, NewYearMonth(2020, 0), "0月は前年の12月")
+	Equal(t, YearMonth{}, NewYearMonth(0, 12), "紀元前の場合空を返却する")
+}
+
+func TestYearMonth_LengthOfMonth(t *testing.T) {
+	for _, table := range []struct {
+		title  string
+		input  YearMonth
+		expect int
+	}{
+		{title: "31日の月", input: YearMonth{Year: 2023, Month: 1}, expect: 31},
+		{title: "30日の月", input: YearMonth{Year: 2023, Month: 4}, expect: 30},
+		{title: "平年の2月", input: YearMonth{Year: 2023, Month: 2}, expect: 28},
+		{title: "閏年の2月", input: YearMonth{Year: 2024, Month: 2}, expect: 29},
+		{title: "100で割り切れる年は平年", input: YearMonth{Year: 1900, Month: 2}, expect: 28},
+		{title: "400で割り切れる年は閏年", input: YearMonth{Year: 2000, Month: 2}, expect: 29},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			Equal(t, table.expect, table.input.LengthOfMonth())
+		})
+	}
+}
+
+func TestYearMonth_AtDay(t *testing.T) {
+	ym := YearMonth{Year: 2023, Month: 2}
+	{
+		actual, err := ym.AtDay(14)
+		Nil(t, err)
+		Equal(t, NewLocalDate(2023, 2, 14), actual)
+	}
+	{
+		_, err := ym.AtDay(29)
+		True(t, errors.Is(err, ErrOutOfRangeDate), "存在しない日はエラー")
+	}
+	{
+		_, err := YearMonth{Year: 2023, Month: 13}.AtDay(1)
+		True(t, errors.Is(err, ErrOutOfRangeDate), "存在しない月はエラー")
+	}
+	Equal(t, NewLocalDate(2023, 2, 28), ym.AtEndOfMonth())
+	Equal(t, LocalDatePeriod{Start: NewLocalDate(2023, 2, 1), End: NewLocalDate(2023, 2, 28)}, ym.Period())
+	Equal(t, LocalDate{}, YearMonth{}.AtEndOfMonth(), "emptyはemptyを返す")
+	Equal(t, LocalDatePeriod{}, YearMonth{}.Period(), "emptyはemptyを返す")
+	Equal(t, LocalDate{}, YearMonth{Year: 2023, Month: 13}.AtEndOfMonth(), "存在しない月はemptyを返す")
+	Equal(t, LocalDatePeriod{}, YearMonth{Year: 2023, Month: 13}.Period(), "存在しない月はemptyを返す")
+}
+
+func TestYearMonth_PlusMonths(t *testing.T) {
+	ym := YearMonth{Year: 2023, Month: 11}
+	Equal(t, YearMonth{Year: 2024, Month: 1}, ym.PlusMonths(2))
+	Equal(t, YearMonth{Year: 2022, Month: 12}, ym.MinusMonths(11))
+	Equal(t, YearMonth{Year: 2025, Month: 11}, ym.PlusYears(2))
+	Equal(t, YearMonth{}, ym.MinusMonths(2023*12), "紀元前の場合空を返却する")
+}
+
+func TestYearMonth_Compare(t *testing.T) {
+	ym1, ym2 := YearMonth{Year: 2023, Month: 12}, YearMonth{Year: 2024, Month: 1}
+	True(t, ym1.Before(ym2))
+	False(t, ym2.Before(ym1))
+	True(t, ym2.After(ym1))
+	False(t, ym1.After(ym1))
+	True(t, ym1.Equal(YearMonth{Year: 2023, Month: 12}))
+}
+
+func TestYearMonth_Scan(t *testing.T) {
+	for _, table := range []struct {
+		title         string
+		value         interface{}
+		expect        YearMonth
+		errorOccurred bool
+	}{
+		{title: "yyyy-MM", value: "2020-05", expect: YearMonth{Year: 2020, Month: 5}},
+		{title: "yyyyMM", value: "202005", expect: YearMonth{Year: 2020, Month: 5}},
+		{title: "yyyy-M", value: "2020-5", expect: YearMonth{Year: 2020, Month: 5}},
+		{title: "yyyy-MM-ddはerror", value: "2020-05-21", errorOccurred: true},
+		{title: "区切りなしの月1桁はerror", value: "20241", errorOccurred: true},
+		{title: "区切りなしの日付はerror", value: "20241016", errorOccurred: true},
+		{title: "empty", value: "", errorOccurred: true},
+		{title: "nil", value: nil, errorOccurred: true},
+		{title: "invalid format", value: "2020/05", errorOccurred: true},
+	} {
+		t.Run(table.title, func(t *testing.T) {
+			ym := new(YearMonth)
+			err := ym.Scan(table.value)
+			if table.errorOccurred {
+				True(t, errors.Is(err, ErrScan))
+			} else {
+				Nil(t, err)
+			}
+			Equal(t, table.expect, *ym)
+		})
+	}
+}
+
+func TestYearMonth_Value(t *testing.T) {
+	value, err := YearMonth{Year: 2020, Month: 5}.Value()
+	Nil(t, err)
+	Equal(t, "2020-05", value)
+}
+
+func TestYearMonth_JSON(t *testing.T) {
+	{
+		jsonBytes, err := json.Marshal(YearMonthStruct{YearMonth: YearMonth{Year: 1999, Month: 2}})
+		Nil(t, err)
+		Equal(t, `{"year_month":"1999-02"}`, string(jsonBytes))
+	}
+	{
+		jsonBytes, err := json.Marshal(YearMonthStruct{})
+		Nil(t, err)
+		Equal(t, `{"year_month":null}`, string(jsonBytes))
+	}
+	{
+		var jsonStruct YearMonthStruct
+		err := json.Unmarshal([]byte(`{"year_month":"2020-07"}`), &jsonStruct)
+		Nil(t, err)
+		Equal(t, YearMonth{Year: 2020, Month: 7}, jsonStruct.YearMonth)
+	}
+	{
+		var jsonStruct YearMonthStruct
+		err := json.Unmarshal([]byte(`{"year_month":"202007"}`), &jsonStruct)
+		True(t, errors.Is(err, ErrUnmarshalJSON), "invalid format")
+	}
+}
+
+func TestYearMonth_UnmarshalFlag(t *testing.T) {
+	{
+		var ym *YearMonth
+		NotNil(t, ym.UnmarshalFlag("2020-04"), "receiver is nil")
+	}
+	{
+		ym := new(YearMonth)
+		NotNil(t, ym.UnmarshalFlag(""), "empty")
+	}
+	{
+		ym := new(YearMonth)
+		Nil(t, ym.UnmarshalFlag("2020-04"))
+		Equal(t, YearMonth{Year: 2020, Month: 4}, *ym)
+	}
+}
@@ -0,0 +1,84 @@
+package nulldates
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/koh789/go-local-date/dates"
+)
+
+// LocalDateArray nullable localDates as date[] of PostgreSQL. NULL elements are not valid.
+type LocalDateArray []LocalDate
+
+// Value for go-sql-driver. nil is NULL
+func (a LocalDateArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	array := make(dates.LocalDateArray, len(a))
+	for i, d := range a {
+		if d.Valid {
+			array[i] = d.LocalDate
+		}
+	}
+	return array.Value()
+}
+
+// Scan for go-sql-driver. nil is a nil slice
+func (a *LocalDateArray) Scan(value interface{}) error {
+	if a == nil {
+		return fmt.Errorf("%w: nulldates.LocalDateArray. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		*a = nil
+		return nil
+	}
+	var array dates.LocalDateArray
+	if err := array.Scan(value); err != nil {
+		*a = nil
+		return err
+	}
+	*a = make(LocalDateArray, len(array))
+	for i, d := range array {
+		(*a)[i] = LocalDate{LocalDate: d, Valid: !d.IsZero()}
+	}
+	return nil
+}
+
+// LocalDatetimeArray nullable localDatetimes as timestamp[] of PostgreSQL. NULL elements are not valid.
+type LocalDatetimeArray []LocalDatetime
+
+// Value for go-sql-driver. nil is NULL
+func (a LocalDatetimeArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	array := make(dates.LocalDatetimeArray, len(a))
+	for i, dt := range a {
+		if dt.Valid {
+			array[i] = dt.LocalDatetime
+		}
+	}
+	return array.Value()
+}
+
+// Scan for go-sql-driver. nil is a nil slice
+func (a *LocalDatetimeArray) Scan(value interface{}) error {
+	if a == nil {
+		return fmt.Errorf("%w: nulldates.LocalDatetimeArray. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		*a = nil
+		return nil
+	}
+	var array dates.LocalDatetimeArray
+	if err := array.Scan(value); err != nil {
+		*a = nil
+		return err
+	}
+	*a = make(LocalDatetimeArray, len(array))
+	for i, dt := range array {
+		(*a)[i] = LocalDatetime{LocalDatetime: dt, Valid: !dt.IsZero()}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package nulldates
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/koh789/go-local-date/dates"
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDateArray_Scan(t *testing.T) {
+	{
+		var a LocalDateArray
+		err := a.Scan("{2024-01-01,NULL}")
+		Nil(t, err, "valid value -> err is nil")
+		Equal(t, LocalDateArray{
+			{LocalDate: dates.NewLocalDate(2024, 1, 1), Valid: true},
+			{Valid: false},
+		}, a, "")
+
+		value, err := a.Value()
+		Nil(t, err)
+		Equal(t, "{2024-01-01,NULL}", value)
+	}
+	{
+		a := LocalDateArray{{Valid: false}}
+		err := a.Scan("{{2024-01-01}}")
+		ErrorIs(t, err, dates.ErrMultidimensionalArray)
+		Nil(t, a, "error -> nil")
+	}
+	{
+		a := LocalDateArray{{Valid: false}}
+		err := a.Scan(nil)
+		Nil(t, err, "nil value -> err is nil")
+		Nil(t, a, "nil value -> nil")
+		value, err := a.Value()
+		Nil(t, err)
+		Nil(t, value, "nil -> NULL")
+	}
+	{
+		var a *LocalDateArray
+		True(t, errors.Is(a.Scan(nil), dates.ErrScan), "receiver is nil")
+	}
+}
+
+func TestLocalDatetimeArray_Scan(t *testing.T) {
+	{
+		var a LocalDatetimeArray
+		err := a.Scan([]byte(`{NULL,"2024-01-01 09:00:00"}`))
+		Nil(t, err, "valid value -> err is nil")
+		Equal(t, LocalDatetimeArray{
+			{Valid: false},
+			{LocalDatetime: dates.NewLocalDatetime(2024, 1, 1, 9, 0, 0), Valid: true},
+		}, a, "")
+
+		value, err := a.Value()
+		Nil(t, err)
+		Equal(t, `{NULL,"2024-01-01 09:00:00"}`, value)
+	}
+	{
+		value, err := LocalDatetimeArray{}.Value()
+		Nil(t, err)
+		Equal(t, "{}", value, "empty -> {}")
+	}
+	{
+		var a *LocalDatetimeArray
+		True(t, errors.Is(a.Scan("{}"), dates.ErrScan), "receiver is nil")
+	}
+}
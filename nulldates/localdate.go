@@ -24,7 +24,7 @@ func (d LocalDate) Value() (driver.Value, error) {
 	return nil, nil
 }
 
-// Scan for go-sql-driver
+// Scan for go-sql-driver. values are converted as dates.LocalDate.Scan. nil is not valid.
 func (d *LocalDate) Scan(value interface{}) error {
 	if d == nil || value == nil {
 		d.LocalDate, d.Valid = dates.LocalDate{}, false
@@ -117,7 +117,7 @@ func (dt LocalDatetime) Value() (driver.Value, error) {
 	return nil, nil
 }
 
-// Scan for go-sql-driver
+// Scan for go-sql-driver. values are converted as dates.LocalDatetime.Scan. nil is not valid.
 func (dt *LocalDatetime) Scan(value interface{}) error {
 	if dt == nil || value == nil {
 		dt.LocalDatetime, dt.Valid = dates.LocalDatetime{}, false
@@ -364,9 +364,16 @@ func TestLocalDate_Scan(t *testing.T) {
 		byteVal := []byte("2020-02-01")
 		dt := new(LocalDate)
 		err := dt.Scan(byteVal)
-		NotNil(t, err, "invalid type value error occurred")
-		Equal(t, dates.LocalDate{}, dt.LocalDate, "")
-		Equal(t, false, dt.Valid, "")
+		Nil(t, err, "[]byte value -> err is nil")
+		Equal(t, dates.LocalDate{Year: 2020, Month: 2, Day: 1}, dt.LocalDate, "")
+		Equal(t, true, dt.Valid, "")
+	}
+	{
+		dt := new(LocalDate)
+		err := dt.Scan(int64(1580515200))
+		Nil(t, err, "unix seconds -> err is nil")
+		Equal(t, dates.LocalDate{Year: 2020, Month: 2, Day: 1}, dt.LocalDate, "")
+		Equal(t, true, dt.Valid, "")
 	}
 	{
 		var value interface{}
@@ -415,6 +422,20 @@ func TestLocalDatetime_Scan(t *testing.T) {
 		Equal(t, expect.LocalDatetime, dtm.LocalDatetime, "")
 		Equal(t, true, dtm.Valid, "")
 	}
+	{
+		loc := time.FixedZone("JST", 9*60*60)
+		for _, value := range []interface{}{
+			time.Date(2020, 2, 1, 15, 10, 15, 500, loc),
+			[]byte("2020-02-01 15:10:15"),
+			int64(1580569815),
+		} {
+			dtm := new(LocalDatetime)
+			err := dtm.Scan(value)
+			Nil(t, err, "time.Time, []byte, unix -> err is nil")
+			Equal(t, dates.NewLocalDatetime(2020, 2, 1, 15, 10, 15), dtm.LocalDatetime, "")
+			Equal(t, true, dtm.Valid, "")
+		}
+	}
 	{
 		value := "2020/02/01 15:10:15"
 		dtm := new(LocalDatetime)
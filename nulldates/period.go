@@ -0,0 +1,72 @@
+package nulldates
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/koh789/go-local-date/dates"
+)
+
+// LocalDatePeriod nullable localDatePeriod. daterange of PostgreSQL
+type LocalDatePeriod struct {
+	LocalDatePeriod dates.LocalDatePeriod
+	Valid           bool
+}
+
+// Value for go-sql-driver
+func (p LocalDatePeriod) Value() (driver.Value, error) {
+	if p.Valid {
+		return p.LocalDatePeriod.Value()
+	}
+	return nil, nil
+}
+
+// Scan for go-sql-driver. empty range is dates.ErrEmptyRange. use PgDateRange for ranges which may be empty.
+func (p *LocalDatePeriod) Scan(value interface{}) error {
+	if p == nil {
+		return fmt.Errorf("%w: nulldates.LocalDatePeriod. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		p.LocalDatePeriod, p.Valid = dates.LocalDatePeriod{}, false
+		return nil
+	}
+	scanErr := p.LocalDatePeriod.Scan(value)
+	if scanErr != nil {
+		p.Valid = false
+	} else {
+		p.Valid = true
+	}
+	return scanErr
+}
+
+// LocalDatetimePeriod nullable localDatetimePeriod. tsrange of PostgreSQL
+type LocalDatetimePeriod struct {
+	LocalDatetimePeriod dates.LocalDatetimePeriod
+	Valid               bool
+}
+
+// Value for go-sql-driver
+func (p LocalDatetimePeriod) Value() (driver.Value, error) {
+	if p.Valid {
+		return p.LocalDatetimePeriod.Value()
+	}
+	return nil, nil
+}
+
+// Scan for go-sql-driver. empty range is dates.ErrEmptyRange. use PgTsRange for ranges which may be empty.
+func (p *LocalDatetimePeriod) Scan(value interface{}) error {
+	if p == nil {
+		return fmt.Errorf("%w: nulldates.LocalDatetimePeriod. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		p.LocalDatetimePeriod, p.Valid = dates.LocalDatetimePeriod{}, false
+		return nil
+	}
+	scanErr := p.LocalDatetimePeriod.Scan(value)
+	if scanErr != nil {
+		p.Valid = false
+	} else {
+		p.Valid = true
+	}
+	return scanErr
+}
@@ -0,0 +1,82 @@
+package nulldates
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/koh789/go-local-date/dates"
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLocalDatePeriod_Scan(t *testing.T) {
+	{
+		p := new(LocalDatePeriod)
+		err := p.Scan("[2024-01-01,2024-02-01)")
+		Nil(t, err, "valid value -> err is nil")
+		Equal(t, dates.LocalDatePeriod{Start: dates.NewLocalDate(2024, 1, 1), End: dates.NewLocalDate(2024, 1, 31)}, p.LocalDatePeriod, "")
+		Equal(t, true, p.Valid, "")
+	}
+	{
+		p := new(LocalDatePeriod)
+		err := p.Scan("[2024-01-01,2024-02-01")
+		NotNil(t, err, "invalid format error occurred")
+		Equal(t, false, p.Valid, "")
+	}
+	{
+		p := new(LocalDatePeriod)
+		err := p.Scan("empty")
+		True(t, errors.Is(err, dates.ErrEmptyRange), "empty range error occurred")
+		Equal(t, false, p.Valid, "")
+	}
+	{
+		p := new(LocalDatePeriod)
+		err := p.Scan(nil)
+		Nil(t, err, "nil value -> err is nil")
+		Equal(t, dates.LocalDatePeriod{}, p.LocalDatePeriod, "")
+		Equal(t, false, p.Valid, "")
+	}
+	{
+		var p *LocalDatePeriod
+		True(t, errors.Is(p.Scan(nil), dates.ErrScan), "receiver is nil")
+	}
+}
+
+func TestLocalDatePeriod_Value(t *testing.T) {
+	{
+		value, err := LocalDatePeriod{}.Value()
+		Nil(t, err, "empty -> err is nil")
+		Nil(t, value, "empty -> value is nil")
+	}
+	{
+		value, err := LocalDatePeriod{LocalDatePeriod: dates.LocalDatePeriod{}, Valid: true}.Value()
+		Nil(t, err)
+		Equal(t, "(,)", value, "zero period -> unbounded")
+	}
+}
+
+func TestLocalDatetimePeriod_Scan(t *testing.T) {
+	{
+		p := new(LocalDatetimePeriod)
+		err := p.Scan([]byte(`["2024-01-01 00:00:00",)`))
+		Nil(t, err, "valid value -> err is nil")
+		Equal(t, dates.LocalDatetimePeriod{Start: dates.NewLocalDatetime(2024, 1, 1, 0, 0, 0)}, p.LocalDatetimePeriod, "")
+		Equal(t, true, p.Valid, "")
+
+		value, err := p.Value()
+		Nil(t, err)
+		Equal(t, `["2024-01-01 00:00:00",)`, value)
+	}
+	{
+		p := new(LocalDatetimePeriod)
+		err := p.Scan(nil)
+		Nil(t, err, "nil value -> err is nil")
+		Equal(t, false, p.Valid, "")
+		value, err := p.Value()
+		Nil(t, err)
+		Nil(t, value)
+	}
+	{
+		var p *LocalDatetimePeriod
+		True(t, errors.Is(p.Scan(nil), dates.ErrScan), "receiver is nil")
+	}
+}
@@ -0,0 +1,72 @@
+package nulldates
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/koh789/go-local-date/dates"
+)
+
+// PgDateRange nullable pgDateRange. daterange of PostgreSQL which may be empty
+type PgDateRange struct {
+	PgDateRange dates.PgDateRange
+	Valid       bool
+}
+
+// Value for go-sql-driver
+func (r PgDateRange) Value() (driver.Value, error) {
+	if r.Valid {
+		return r.PgDateRange.Value()
+	}
+	return nil, nil
+}
+
+// Scan for go-sql-driver. empty range is valid and Empty
+func (r *PgDateRange) Scan(value interface{}) error {
+	if r == nil {
+		return fmt.Errorf("%w: nulldates.PgDateRange. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		r.PgDateRange, r.Valid = dates.PgDateRange{}, false
+		return nil
+	}
+	scanErr := r.PgDateRange.Scan(value)
+	if scanErr != nil {
+		r.Valid = false
+	} else {
+		r.Valid = true
+	}
+	return scanErr
+}
+
+// PgTsRange nullable pgTsRange. tsrange of PostgreSQL which may be empty
+type PgTsRange struct {
+	PgTsRange dates.PgTsRange
+	Valid     bool
+}
+
+// Value for go-sql-driver
+func (r PgTsRange) Value() (driver.Value, error) {
+	if r.Valid {
+		return r.PgTsRange.Value()
+	}
+	return nil, nil
+}
+
+// Scan for go-sql-driver. empty range is valid and Empty
+func (r *PgTsRange) Scan(value interface{}) error {
+	if r == nil {
+		return fmt.Errorf("%w: nulldates.PgTsRange. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		r.PgTsRange, r.Valid = dates.PgTsRange{}, false
+		return nil
+	}
+	scanErr := r.PgTsRange.Scan(value)
+	if scanErr != nil {
+		r.Valid = false
+	} else {
+		r.Valid = true
+	}
+	return scanErr
+}
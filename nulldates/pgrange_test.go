@@ -0,0 +1,71 @@
+package nulldates
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/koh789/go-local-date/dates"
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestPgDateRange_Scan(t *testing.T) {
+	{
+		r := new(PgDateRange)
+		err := r.Scan("empty")
+		Nil(t, err, "empty range -> err is nil")
+		Equal(t, PgDateRange{PgDateRange: dates.PgDateRange{Empty: true}, Valid: true}, *r)
+
+		value, err := r.Value()
+		Nil(t, err)
+		Equal(t, "empty", value)
+	}
+	{
+		r := new(PgDateRange)
+		err := r.Scan([]byte("[2024-01-01,2024-02-01)"))
+		Nil(t, err, "valid value -> err is nil")
+		Equal(t, dates.LocalDatePeriod{Start: dates.NewLocalDate(2024, 1, 1), End: dates.NewLocalDate(2024, 1, 31)}, r.PgDateRange.Period)
+		Equal(t, true, r.Valid)
+	}
+	{
+		r := new(PgDateRange)
+		NotNil(t, r.Scan("[2024-01-01,2024-02-01"), "invalid format error occurred")
+		Equal(t, false, r.Valid)
+	}
+	{
+		r := new(PgDateRange)
+		Nil(t, r.Scan(nil), "nil value -> err is nil")
+		Equal(t, PgDateRange{}, *r)
+		value, err := r.Value()
+		Nil(t, err)
+		Nil(t, value, "not valid -> value is nil")
+	}
+	{
+		var r *PgDateRange
+		True(t, errors.Is(r.Scan("empty"), dates.ErrScan), "receiver is nil")
+	}
+}
+
+func TestPgTsRange_Scan(t *testing.T) {
+	{
+		r := new(PgTsRange)
+		Nil(t, r.Scan([]byte("empty")), "empty range -> err is nil")
+		Equal(t, PgTsRange{PgTsRange: dates.PgTsRange{Empty: true}, Valid: true}, *r)
+	}
+	{
+		r := new(PgTsRange)
+		Nil(t, r.Scan(`["2024-01-01 00:00:00",)`))
+		Equal(t, dates.LocalDatetimePeriod{Start: dates.NewLocalDatetime(2024, 1, 1, 0, 0, 0)}, r.PgTsRange.Period)
+		value, err := r.Value()
+		Nil(t, err)
+		Equal(t, `["2024-01-01 00:00:00",)`, value)
+	}
+	{
+		r := new(PgTsRange)
+		Nil(t, r.Scan(nil), "nil value -> err is nil")
+		Equal(t, PgTsRange{}, *r)
+	}
+	{
+		var r *PgTsRange
+		True(t, errors.Is(r.Scan("empty"), dates.ErrScan), "receiver is nil")
+	}
+}
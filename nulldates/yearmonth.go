@@ -0,0 +1,108 @@
+package nulldates
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/koh789/go-local-date/dates"
+)
+
+// YearMonth nullable yearMonth
+type YearMonth struct {
+	YearMonth dates.YearMonth
+	Valid     bool
+}
+
+// Value for go-sql-driver
+func (ym YearMonth) Value() (driver.Value, error) {
+	if ym.Valid {
+		return ym.YearMonth.Value()
+	}
+	return nil, nil
+}
+
+// Scan for go-sql-driver
+func (ym *YearMonth) Scan(value interface{}) error {
+	if ym == nil {
+		return fmt.Errorf("%w: nulldates.YearMonth. receiver is nil", dates.ErrScan)
+	}
+	if value == nil {
+		ym.YearMonth, ym.Valid = dates.YearMonth{}, false
+		return nil
+	}
+	scanErr := ym.YearMonth.Scan(value)
+	if scanErr != nil {
+		ym.Valid = false
+	} else {
+		ym.Valid = true
+	}
+	return scanErr
+}
+
+// MarshalJSON for json return format: yyyy-MM
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	if ym.Valid {
+		return ym.YearMonth.MarshalJSON()
+	}
+	return json.Marshal(nil)
+}
+
+// UnmarshalJSON for json default format yyyy-MM
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if ym == nil {
+		return fmt.Errorf("%w: nulldates.YearMonth. receiver is nil", dates.ErrUnmarshalJSON)
+	}
+	if len(data) == 0 || strings.EqualFold(string(data), "null") {
+		ym.YearMonth, ym.Valid = dates.YearMonth{}, false
+		return nil
+	}
+	if err := ym.YearMonth.UnmarshalJSON(data); err != nil {
+		ym.YearMonth, ym.Valid = dates.YearMonth{}, false
+		return err
+	}
+	ym.Valid = true
+	return nil
+}
+
+func (ym *YearMonth) UnmarshalFlag(s string) error {
+	if ym == nil {
+		return fmt.Errorf("%w: nulldates.YearMonth. receiver is nil", dates.ErrUnmarshalFlag)
+	}
+	if len(s) == 0 {
+		ym.YearMonth, ym.Valid = dates.YearMonth{}, false
+		return nil
+	}
+	if err := ym.YearMonth.UnmarshalFlag(s); err != nil {
+		ym.YearMonth, ym.Valid = dates.YearMonth{}, false
+		return err
+	}
+	ym.Valid = true
+	return nil
+}
+
+// NewYearMonth new YearMonth
+func NewYearMonth(year, month int) YearMonth {
+	return YearMonthFromYearMonth(dates.NewYearMonth(year, month))
+}
+
+// YearMonthFromYearMonth dates.YearMonth to YearMonth
+func YearMonthFromYearMonth(ym dates.YearMonth) YearMonth {
+	if ym.IsZero() {
+		return YearMonth{Valid: false}
+	}
+	return YearMonth{YearMonth: ym, Valid: true}
+}
+
+// YearMonthFromDate localDate to YearMonth
+func YearMonthFromDate(d dates.LocalDate) YearMonth {
+	return YearMonthFromYearMonth(dates.YearMonthFromDate(d))
+}
+
+func YearMonthFromPtr(ym *dates.YearMonth) YearMonth {
+	if ym == nil {
+		return YearMonth{Valid: false}
+	}
+	return YearMonthFromYearMonth(*ym)
+}
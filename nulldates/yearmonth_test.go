@@ -0,0 +1,102 @@
+package nulldates
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/koh789/go-local-date/dates"
+	. "github.com/stretchr/testify/assert"
+)
+
+type NullYearMonthStruct struct {
+	YearMonth YearMonth `json:"year_month,omitempty"`
+}
+
+func TestYearMonth_MarshalJSON(t *testing.T) {
+	{
+		jsonBytes, err := json.Marshal(NullYearMonthStruct{YearMonth: NewYearMonth(2020, 3)})
+		Nil(t, err)
+		Equal(t, `{"year_month":"2020-03"}`, string(jsonBytes))
+	}
+	{
+		jsonBytes, err := json.Marshal(NullYearMonthStruct{})
+		Nil(t, err)
+		Equal(t, `{"year_month":null}`, string(jsonBytes), "omitemptyは効かない")
+	}
+}
+
+func TestYearMonth_UnmarshalJSON(t *testing.T) {
+	{
+		var jsonStruct NullYearMonthStruct
+		err := json.Unmarshal([]byte(`{"year_month":"2020-07"}`), &jsonStruct)
+		Nil(t, err)
+		Equal(t, NullYearMonthStruct{YearMonth: NewYearMonth(2020, 7)}, jsonStruct)
+	}
+	{
+		var jsonStruct NullYearMonthStruct
+		err := json.Unmarshal([]byte(`{"year_month":null}`), &jsonStruct)
+		Nil(t, err)
+		Equal(t, NullYearMonthStruct{}, jsonStruct)
+	}
+	{
+		var jsonStruct NullYearMonthStruct
+		err := json.Unmarshal([]byte(`{"year_month":"2020/07"}`), &jsonStruct)
+		NotNil(t, err)
+		Equal(t, NullYearMonthStruct{}, jsonStruct, "invalid format")
+	}
+}
+
+func TestYearMonth_UnmarshalFlag(t *testing.T) {
+	{
+		var ym *YearMonth
+		NotNil(t, ym.UnmarshalFlag("2020-04"), "receiver is nil")
+	}
+	{
+		ym := new(YearMonth)
+		Nil(t, ym.UnmarshalFlag(""))
+		Equal(t, YearMonth{}, *ym, "emptyがセットされるが,errorは発生しない")
+	}
+	{
+		ym := new(YearMonth)
+		Nil(t, ym.UnmarshalFlag("2020-04"))
+		Equal(t, YearMonth{YearMonth: dates.YearMonth{Year: 2020, Month: 4}, Valid: true}, *ym)
+	}
+}
+
+func TestYearMonth_Scan(t *testing.T) {
+	{
+		var ym *YearMonth
+		True(t, errors.Is(ym.Scan("2020-05"), dates.ErrScan), "receiver is nil")
+	}
+	{
+		ym := new(YearMonth)
+		Nil(t, ym.Scan(nil))
+		Equal(t, YearMonth{}, *ym)
+	}
+	{
+		ym := new(YearMonth)
+		Nil(t, ym.Scan("2020-05"))
+		Equal(t, NewYearMonth(2020, 5), *ym)
+	}
+	{
+		ym := new(YearMonth)
+		NotNil(t, ym.Scan("2020/05"))
+		False(t, ym.Valid)
+	}
+}
+
+func TestYearMonth_Value(t *testing.T) {
+	{
+		value, err := YearMonth{}.Value()
+		Nil(t, err)
+		Nil(t, value)
+	}
+	{
+		value, err := NewYearMonth(2020, 5).Value()
+		Nil(t, err)
+		Equal(t, "2020-05", value)
+	}
+	Equal(t, YearMonth{}, YearMonthFromPtr(nil))
+	Equal(t, NewYearMonth(2020, 5), YearMonthFromDate(dates.NewLocalDate(2020, 5, 3)))
+}